@@ -3,7 +3,6 @@ package envcfg
 // Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
 
 import (
-	"errors"
 	"strconv"
 	"strings"
 )
@@ -16,18 +15,17 @@ import (
 //
 // Available options:
 // 		- "default" or BoolDefault
+// 		- "file_ok" or FileOK
 // 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 func (c *Cfg) Bool(docOpts string, opts ...BoolOpt) (v bool) {
 	s, err := newBoolSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).(bool)
+	v, _ = c.register(s).(bool)
 	return
 }
 
@@ -55,7 +53,7 @@ var _ BoolOpt = new(boolOptFunc)
 func newBoolSpec(docOpts string, opts []BoolOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(boolParser)
@@ -71,24 +69,15 @@ func newBoolSpec(docOpts string, opts []BoolOpt) (*spec, error) {
 			opt BoolOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
-		case "optional":
-			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
-			}
-			opt = Optional
+		switch key := strings.ToLower(f[0]); key {
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyBoolParser(p)
@@ -101,7 +90,10 @@ func newBoolSpec(docOpts string, opts []BoolOpt) (*spec, error) {
 
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -117,6 +109,11 @@ func (p *boolParser) parse(s string) (interface{}, error) {
 	)
 }
 
+func (p *boolParser) format(val interface{}) string {
+	v, _ := val.(bool)
+	return strconv.FormatBool(v)
+}
+
 func (p *boolParser) describe() interface{} {
 	return struct{}{}
 }
@@ -0,0 +1,220 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// BoolSlice extracts and parses a []bool variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe BoolSliceOpts.
+//
+// Available options:
+// 		- "comma" or BoolSliceComma
+// 		- "default" or BoolSliceDefault
+// 		- "file_ok" or FileOK
+// 		- "max_len" or BoolSliceMaxLen
+// 		- "min_len" or BoolSliceMinLen
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) BoolSlice(docOpts string, opts ...BoolSliceOpt) (v []bool) {
+	s, err := newBoolSliceSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).([]bool)
+	return
+}
+
+// BoolSliceOpt modifies BoolSlice variable configuration.
+type BoolSliceOpt interface {
+	modify(s *spec)
+	modifyBoolSliceParser(p *boolSliceParser)
+}
+
+// BoolSliceComma specifies the comma to use for a BoolSlice variable.
+func BoolSliceComma(comma rune) BoolSliceOpt {
+	return boolSliceOptFunc(func(p *boolSliceParser) {
+		p.comma = comma
+	})
+}
+
+// BoolSliceDefault specifies a default value for a BoolSlice variable.
+func BoolSliceDefault(def []bool) BoolSliceOpt {
+	return defaultOpt(def)
+}
+
+// BoolSliceMaxLen specifies the maximum permitted length for a BoolSlice variable.
+func BoolSliceMaxLen(maxLen int) BoolSliceOpt {
+	return boolSliceOptFunc(func(p *boolSliceParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// BoolSliceMinLen specifies the minimum permitted length for a BoolSlice variable.
+func BoolSliceMinLen(minLen int) BoolSliceOpt {
+	return boolSliceOptFunc(func(p *boolSliceParser) {
+		p.minLen = minLen
+	})
+}
+
+type boolSliceOptFunc func(p *boolSliceParser)
+
+func (f boolSliceOptFunc) modifyBoolSliceParser(p *boolSliceParser) {
+	f(p)
+}
+
+func (boolSliceOptFunc) modify(*spec) {}
+
+var _ BoolSliceOpt = new(boolSliceOptFunc)
+
+func newBoolSliceSpec(docOpts string, opts []BoolSliceOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(boolSliceParser)
+	s := &spec{
+		parser:   p,
+		typeName: "[]bool",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt BoolSliceOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "comma":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = BoolSliceComma(value[0])
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = BoolSliceMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = BoolSliceMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyBoolSliceParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyBoolSliceParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type boolSliceParser struct {
+	comma  rune
+	maxLen int
+	minLen int
+}
+
+func (p *boolSliceParser) parse(s string) (interface{}, error) {
+	ses, err := parseSlice(s, p.comma)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make([]bool, len(ses))
+	for i, v := range ses {
+		el, err := strconv.ParseBool(
+			v,
+		)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		vals[i] = el
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+
+}
+
+func (p *boolSliceParser) validate(v []bool) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *boolSliceParser) format(val interface{}) string {
+	vals, _ := val.([]bool)
+	ses := make([]string, len(vals))
+	for i, v := range vals {
+		ses[i] = strconv.FormatBool(v)
+	}
+	return formatSlice(ses, p.comma)
+}
+
+func (p *boolSliceParser) describe() interface{} {
+	return boolSliceParserDescription{
+		Comma:  p.comma,
+		MaxLen: p.maxLen,
+		MinLen: p.minLen,
+	}
+}
+
+type boolSliceParserDescription struct {
+	Comma  rune `json:"comma,omitempty"`
+	MaxLen int  `json:"max_len,omitempty"`
+	MinLen int  `json:"min_len,omitempty"`
+}
+
+func (d boolSliceParserDescription) MarshalJSON() ([]byte, error) {
+	var comma string
+	if d.Comma != 0 {
+		comma = string(d.Comma)
+	}
+	return json.Marshal(struct {
+		Comma  string `json:"comma,omitempty"`
+		MaxLen int    `json:"max_len,omitempty"`
+		MinLen int    `json:"min_len,omitempty"`
+	}{
+		Comma:  comma,
+		MaxLen: d.MaxLen,
+		MinLen: d.MinLen,
+	})
+}
@@ -0,0 +1,215 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ByteSize extracts and parses a ByteSize variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe ByteSizeOpts.
+//
+// Available options:
+// 		- "default" or ByteSizeDefault
+// 		- "file_ok" or FileOK
+// 		- "iec" or ByteSizeIEC
+// 		- "max" or ByteSizeMax
+// 		- "min" or ByteSizeMin
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) ByteSize(docOpts string, opts ...ByteSizeOpt) (v ByteSize) {
+	s, err := newByteSizeSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).(ByteSize)
+	return
+}
+
+// ByteSizeOpt modifies ByteSize variable configuration.
+type ByteSizeOpt interface {
+	modify(s *spec)
+	modifyByteSizeParser(p *byteSizeParser)
+}
+
+// ByteSizeDefault specifies a default value for a ByteSize variable.
+func ByteSizeDefault(def ByteSize) ByteSizeOpt {
+	return defaultOpt(def)
+}
+
+// ByteSizeIEC specifies that units such as KB are powers of 1024 for a ByteSize variable.
+func ByteSizeIEC(iec bool) ByteSizeOpt {
+	return byteSizeOptFunc(func(p *byteSizeParser) {
+		p.iec = iec
+	})
+}
+
+// ByteSizeMax specifies the maximum permitted value for a ByteSize variable.
+func ByteSizeMax(max ByteSize) ByteSizeOpt {
+	return byteSizeOptFunc(func(p *byteSizeParser) {
+		p.max = &max
+	})
+}
+
+// ByteSizeMin specifies the minimum permitted value for a ByteSize variable.
+func ByteSizeMin(min ByteSize) ByteSizeOpt {
+	return byteSizeOptFunc(func(p *byteSizeParser) {
+		p.min = &min
+	})
+}
+
+type byteSizeOptFunc func(p *byteSizeParser)
+
+func (f byteSizeOptFunc) modifyByteSizeParser(p *byteSizeParser) {
+	f(p)
+}
+
+func (byteSizeOptFunc) modify(*spec) {}
+
+var _ ByteSizeOpt = new(byteSizeOptFunc)
+
+func newByteSizeSpec(docOpts string, opts []ByteSizeOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(byteSizeParser)
+	s := &spec{
+		parser:   p,
+		typeName: "ByteSize",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	values := make(map[string]string)
+	for _, f := range parsed.fields {
+		var (
+			opt ByteSizeOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "iec":
+			val := true // a bare flag is set
+			if f[1] != "" {
+				val, err = strconv.ParseBool(f[1])
+			}
+			opt = ByteSizeIEC(val)
+		case "max":
+			values[key] = f[1]
+			continue
+		case "min":
+			values[key] = f[1]
+			continue
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyByteSizeParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyByteSizeParser(p)
+	}
+
+	// value params are parsed once the options they depend on are known, but typed options take precedence
+	if val, ok := values["max"]; ok {
+		v, err := p.parse(val)
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "max", Err: err}
+		}
+		if p.max == nil {
+			max := v.(ByteSize)
+			p.max = &max
+		}
+	}
+
+	if val, ok := values["min"]; ok {
+		v, err := p.parse(val)
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "min", Err: err}
+		}
+		if p.min == nil {
+			min := v.(ByteSize)
+			p.min = &min
+		}
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type byteSizeParser struct {
+	iec bool
+	max *ByteSize
+	min *ByteSize
+}
+
+func (p *byteSizeParser) parse(s string) (interface{}, error) {
+	v, err := parseByteSize(
+		s,
+		p.iec,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validate(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *byteSizeParser) validate(v ByteSize) error {
+	if p.min != nil && v < *p.min {
+		return valueErrorf("must be at least %v", p.format(*p.min))
+	}
+	if p.max != nil && v > *p.max {
+		return valueErrorf("must be at most %v", p.format(*p.max))
+	}
+	return nil
+}
+
+func (p *byteSizeParser) format(val interface{}) string {
+	v, _ := val.(ByteSize)
+	return formatByteSize(v, p.iec)
+}
+
+func (p *byteSizeParser) describe() interface{} {
+	d := byteSizeParserDescription{
+		IEC: p.iec,
+	}
+	if p.max != nil {
+		d.Max = p.format(*p.max)
+	}
+	if p.min != nil {
+		d.Min = p.format(*p.min)
+	}
+	return d
+}
+
+type byteSizeParserDescription struct {
+	IEC bool   `json:"iec,omitempty"`
+	Max string `json:"max,omitempty"`
+	Min string `json:"min,omitempty"`
+}
@@ -0,0 +1,100 @@
+package envcfg
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ByteSize is a count of bytes, written in the environment with an optional unit, e.g. 512KiB, 10MB or 1.5G.
+//
+// Units with an i -- KiB, MiB, GiB, TiB, PiB and EiB -- are powers of 1024. Units without -- K or KB, M or MB etc. -- are
+// powers of 1000, as in SI, unless ByteSizeIEC is specified, in which case they're powers of 1024 too. Units are case
+// insensitive and a trailing B is optional.
+type ByteSize uint64
+
+// String formats the size with the largest unit which represents it exactly.
+func (b ByteSize) String() string {
+	return formatByteSize(b, false)
+}
+
+const byteSizePrefixes = "kmgtpe"
+
+func parseByteSize(s string, iec bool) (ByteSize, error) {
+	s = strings.TrimSpace(s)
+	idx := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if idx < 0 {
+		idx = len(s)
+	}
+	num, unit := s[:idx], strings.ToLower(strings.TrimSpace(s[idx:]))
+
+	n, ok := new(big.Rat).SetString(num)
+	if num == "" || !ok {
+		return 0, valueError("invalid size")
+	}
+
+	if unit != "" && unit != "b" {
+		exp := strings.IndexByte(byteSizePrefixes, unit[0]) + 1
+		if exp == 0 {
+			return 0, valueError("invalid unit")
+		}
+		base := int64(1000)
+		switch unit[1:] {
+		case "", "b":
+			if iec {
+				base = 1024
+			}
+		case "i", "ib":
+			base = 1024
+		default:
+			return 0, valueError("invalid unit")
+		}
+		n.Mul(n, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(base), big.NewInt(int64(exp)), nil)))
+	}
+
+	if !n.IsInt() {
+		return 0, valueError("must be a whole number of bytes")
+	}
+	if !n.Num().IsUint64() {
+		return 0, strconv.ErrRange
+	}
+	return ByteSize(n.Num().Uint64()), nil
+}
+
+// formatByteSize formats the size with whichever unit represents it exactly with the smallest number; units which iec
+// would make ambiguous are not used.
+func formatByteSize(b ByteSize, iec bool) string {
+	var (
+		v    = uint64(b)
+		best = strconv.FormatUint(v, 10)
+		min  = v
+	)
+	if v == 0 {
+		return best
+	}
+	for _, base := range [...]uint64{1024, 1000} {
+		if base == 1000 && iec {
+			continue
+		}
+		unit := uint64(1)
+		for _, prefix := range byteSizePrefixes {
+			if unit > v/base {
+				break
+			}
+			unit *= base
+			if v%unit != 0 || v/unit >= min {
+				continue
+			}
+			min = v / unit
+			best = strconv.FormatUint(min, 10) + strings.ToUpper(string(prefix))
+			if base == 1024 {
+				best += "iB"
+			} else {
+				best += "B"
+			}
+		}
+	}
+	return best
+}
@@ -0,0 +1,77 @@
+package envcfg
+
+import (
+	"math"
+	"testing"
+)
+
+func Test_parseByteSize(t *testing.T) {
+	tests := []struct {
+		s       string
+		iec     bool
+		want    ByteSize
+		wantErr string
+	}{
+		{s: "0", want: 0},
+		{s: "512", want: 512},
+		{s: "512B", want: 512},
+		{s: "512KiB", want: 512 << 10},
+		{s: "512 kib", want: 512 << 10},
+		{s: "10MB", want: 10e6},
+		{s: "10MB", iec: true, want: 10 << 20},
+		{s: "10Mi", want: 10 << 20},
+		{s: "1.5G", want: 1.5e9},
+		{s: "1.5G", iec: true, want: 3 << 29},
+		{s: ".5k", want: 500},
+		{s: "16EiB", wantErr: "value out of range"},
+		{s: "18446744073709551615", want: math.MaxUint64},
+		{s: "1.5", wantErr: "must be a whole number of bytes"},
+		{s: "", wantErr: "invalid size"},
+		{s: "-1", wantErr: "invalid size"},
+		{s: "1.2.3", wantErr: "invalid size"},
+		{s: "1Q", wantErr: "invalid unit"},
+		{s: "1KBi", wantErr: "invalid unit"},
+	}
+	for _, tt := range tests {
+		got, err := parseByteSize(tt.s, tt.iec)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("parseByteSize(%q, %v) err = %v, want %v", tt.s, tt.iec, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseByteSize(%q, %v) unexpected error: %v", tt.s, tt.iec, err)
+		} else if got != tt.want {
+			t.Errorf("parseByteSize(%q, %v) = %v, want %v", tt.s, tt.iec, uint64(got), uint64(tt.want))
+		}
+	}
+}
+
+func Test_formatByteSize(t *testing.T) {
+	tests := []struct {
+		b    ByteSize
+		iec  bool
+		want string
+	}{
+		{0, false, "0"},
+		{1023, false, "1023"},
+		{1000, false, "1KB"},
+		{1000, true, "1000"},
+		{1024, false, "1KiB"},
+		{1.5e9, false, "1500MB"},
+		{3 << 29, false, "1536MiB"},
+		{10e6, false, "10MB"},
+		{math.MaxUint64, false, "18446744073709551615"},
+		{1 << 60, true, "1EiB"},
+	}
+	for _, tt := range tests {
+		got := formatByteSize(tt.b, tt.iec)
+		if got != tt.want {
+			t.Errorf("formatByteSize(%v, %v) = %v, want %v", uint64(tt.b), tt.iec, got, tt.want)
+		}
+		if back, err := parseByteSize(got, tt.iec); err != nil || back != tt.b {
+			t.Errorf("parseByteSize(%q, %v) = %v, %v, want %v", got, tt.iec, uint64(back), err, uint64(tt.b))
+		}
+	}
+}
@@ -17,21 +17,22 @@ import (
 //
 // Available options:
 // 		- "default" or BytesDefault
+// 		- "file_ok" or FileOK
+// 		- "max_len" or BytesMaxLen
+// 		- "min_len" or BytesMinLen
 // 		- "no_padding" or BytesNoPadding
 // 		- "optional" or Optional
 // 		- "padding" or BytesPadding
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 // 		- "url_safe" or BytesURLSafe
 func (c *Cfg) Bytes(docOpts string, opts ...BytesOpt) (v []byte) {
 	s, err := newBytesSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).([]byte)
+	v, _ = c.register(s).([]byte)
 	return
 }
 
@@ -46,6 +47,20 @@ func BytesDefault(def []byte) BytesOpt {
 	return defaultOpt(def)
 }
 
+// BytesMaxLen specifies the maximum permitted length for a Bytes variable.
+func BytesMaxLen(maxLen int) BytesOpt {
+	return bytesOptFunc(func(p *bytesParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// BytesMinLen specifies the minimum permitted length for a Bytes variable.
+func BytesMinLen(minLen int) BytesOpt {
+	return bytesOptFunc(func(p *bytesParser) {
+		p.minLen = minLen
+	})
+}
+
 // BytesNoPadding disables padding for a Bytes variable.
 func BytesNoPadding(noPadding bool) BytesOpt {
 	return bytesOptFunc(func(p *bytesParser) {
@@ -80,7 +95,7 @@ var _ BytesOpt = new(bytesOptFunc)
 func newBytesSpec(docOpts string, opts []BytesOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(bytesParser)
@@ -96,22 +111,21 @@ func newBytesSpec(docOpts string, opts []BytesOpt) (*spec, error) {
 			opt BytesOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
+		switch key := strings.ToLower(f[0]); key {
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = BytesMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = BytesMinLen(val)
 		case "no_padding":
-			var val bool
-			val, err = strconv.ParseBool(f[1])
-			opt = BytesNoPadding(val)
-		case "optional":
+			val := true // a bare flag is set
 			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
+				val, err = strconv.ParseBool(f[1])
 			}
-			opt = Optional
+			opt = BytesNoPadding(val)
 		case "padding":
 			value := []rune(f[1])
 			if len(value) != 1 {
@@ -120,15 +134,19 @@ func newBytesSpec(docOpts string, opts []BytesOpt) (*spec, error) {
 			}
 			opt = BytesPadding(value[0])
 		case "url_safe":
-			var val bool
-			val, err = strconv.ParseBool(f[1])
+			val := true // a bare flag is set
+			if f[1] != "" {
+				val, err = strconv.ParseBool(f[1])
+			}
 			opt = BytesURLSafe(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyBytesParser(p)
@@ -141,7 +159,10 @@ func newBytesSpec(docOpts string, opts []BytesOpt) (*spec, error) {
 
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -149,22 +170,48 @@ func newBytesSpec(docOpts string, opts []BytesOpt) (*spec, error) {
 }
 
 type bytesParser struct {
+	maxLen    int
+	minLen    int
 	noPadding bool
 	padding   rune
 	urlSafe   bool
 }
 
 func (p *bytesParser) parse(s string) (interface{}, error) {
-	return parseBytes(
+	v, err := parseBytes(
 		s,
 		p.padding,
 		p.noPadding,
 		p.urlSafe,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validate(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *bytesParser) validate(v []byte) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *bytesParser) format(val interface{}) string {
+	v, _ := val.([]byte)
+	return formatBytes(v, p.padding, p.noPadding, p.urlSafe)
 }
 
 func (p *bytesParser) describe() interface{} {
 	return bytesParserDescription{
+		MaxLen:    p.maxLen,
+		MinLen:    p.minLen,
 		NoPadding: p.noPadding,
 		Padding:   p.padding,
 		URLSafe:   p.urlSafe,
@@ -172,6 +219,8 @@ func (p *bytesParser) describe() interface{} {
 }
 
 type bytesParserDescription struct {
+	MaxLen    int  `json:"max_len,omitempty"`
+	MinLen    int  `json:"min_len,omitempty"`
 	NoPadding bool `json:"no_padding,omitempty"`
 	Padding   rune `json:"padding,omitempty"`
 	URLSafe   bool `json:"url_safe,omitempty"`
@@ -183,10 +232,14 @@ func (d bytesParserDescription) MarshalJSON() ([]byte, error) {
 		padding = string(d.Padding)
 	}
 	return json.Marshal(struct {
+		MaxLen    int    `json:"max_len,omitempty"`
+		MinLen    int    `json:"min_len,omitempty"`
 		NoPadding bool   `json:"no_padding,omitempty"`
 		Padding   string `json:"padding,omitempty"`
 		URLSafe   bool   `json:"url_safe,omitempty"`
 	}{
+		MaxLen:    d.MaxLen,
+		MinLen:    d.MinLen,
 		NoPadding: d.NoPadding,
 		Padding:   padding,
 		URLSafe:   d.URLSafe,
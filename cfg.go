@@ -1,20 +1,25 @@
 package envcfg
 
-//go:generate go run internal/cmd/gen/gen.go internal/cmd/gen/spec.gen.go.tmpl internal/cmd/gen/uni_opt.gen.go.tmpl
+//go:generate go run internal/cmd/gen/gen.go internal/cmd/gen/spec.gen.go.tmpl internal/cmd/gen/uni_opt.gen.go.tmpl internal/cmd/gen/load.gen.go.tmpl
 import (
+	"bytes"
+	"encoding"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
+	"io/ioutil"
+	"reflect"
+	"strings"
 )
 
 func New(opts ...Option) *Cfg {
 	var defaults = []Option{
-		EnvFunc(os.LookupEnv),
-		Panic(true),
+		Sources(ProcessEnv()),
+		Panic(false),
 		ErrMaker(defaultErrMaker),
 	}
 
-	c := new(Cfg)
+	c := &Cfg{state: new(state)}
 	for _, o := range append(defaults, opts...) {
 		o(c)
 	}
@@ -23,44 +28,109 @@ func New(opts ...Option) *Cfg {
 }
 
 type Cfg struct {
-	envFunc func(s string) (string, bool)
+	*state
+
+	prefix, group string
+}
+
+// state is shared by a Cfg and the children returned by Prefix and Group.
+type state struct {
+	sources sources
 
 	descriptions []Description
 
 	panic    bool
 	errors   []error
 	errMaker func(err []error) error
+
+	fileOK bool
+	strict []string
+
+	watched // guarded by its mutex, since Watch reloads concurrently
+}
+
+// Prefix returns a child Cfg which prepends the prefix to the name of every variable it extracts, allowing a reusable
+// component to declare its variables once and be configured several times, e.g. as PAYMENTS_POOL_SIZE and
+// ORDERS_POOL_SIZE. Prefixes of nested children are concatenated.
+//
+// The child shares its parent's sources, options, errors and descriptions, which record the full names.
+func (c *Cfg) Prefix(prefix string) *Cfg {
+	return &Cfg{state: c.state, prefix: c.prefix + prefix, group: c.group}
+}
+
+// Group returns a child Cfg which labels the Descriptions of the variables it extracts with the group, replacing any
+// group of its parent. Like Prefix, the child shares its parent's state.
+func (c *Cfg) Group(label string) *Cfg {
+	return &Cfg{state: c.state, prefix: c.prefix, group: label}
 }
 
 func (c *Cfg) Has(s string) bool {
+	s = c.prefix + s
+	_, provenance, ok := c.sources.lookup(s)
+	if !ok {
+		provenance = provenanceDefault
+	}
 	c.addDescription(Description{
-		Name:     s,
-		Type:     "bool",
-		Optional: true,
-		Default:  &DefaultValDescription{false},
+		Name:       s,
+		Type:       "bool",
+		Optional:   true,
+		Default:    &DefaultValDescription{Value: false, String: "false"},
+		Provenance: provenance,
+		Group:      c.group,
 	})
-	_, ok := c.envFunc(s)
 	return ok
 }
 
 func (c *Cfg) HasNot(s string) bool {
+	s = c.prefix + s
+	_, provenance, ok := c.sources.lookup(s)
+	if !ok {
+		provenance = provenanceDefault
+	}
 	c.addDescription(Description{
-		Name:     s,
-		Type:     "bool",
-		Optional: true,
-		Default:  &DefaultValDescription{true},
+		Name:       s,
+		Type:       "bool",
+		Optional:   true,
+		Default:    &DefaultValDescription{Value: true, String: "true"},
+		Provenance: provenance,
+		Group:      c.group,
 	})
-	_, ok := c.envFunc(s)
 	return !ok
 }
 
 func (c *Cfg) Err() error {
-	if len(c.errors) > 0 {
-		return c.errMaker(c.errors)
+	errs := append(c.errors[:len(c.errors):len(c.errors)], c.undeclared()...)
+	if len(errs) > 0 {
+		return c.errMaker(errs)
 	}
 	return nil
 }
 
+// undeclared returns errors for any variables set with a Strict prefix which haven't been declared.
+func (c *Cfg) undeclared() []error {
+	if len(c.strict) == 0 {
+		return nil
+	}
+	if !c.sources.listable() {
+		return []error{ErrNotListable}
+	}
+	declared := make(map[string]bool, len(c.descriptions))
+	for _, d := range c.descriptions {
+		declared[d.Name] = true
+		if d.FileOK {
+			declared[d.Name+fileSuffix] = true
+		}
+	}
+	var errs []error
+	for _, prefix := range c.strict {
+		for _, err := range c.sources.undeclared(prefix, declared) {
+			errs = append(errs, err)
+			declared[err.Name] = true // reported once, even under overlapping prefixes
+		}
+	}
+	return errs
+}
+
 func (c *Cfg) Describe() []Description {
 	return c.descriptions
 }
@@ -76,28 +146,94 @@ type Description struct {
 	Default  *DefaultValDescription `json:"default,omitempty"`
 	Params   interface{}            `json:"params,omitempty"`
 	Comment  string                 `json:"comment,omitempty"`
+	// Provenance is the name of the Source which provided the value or "default" if the default was used.
+	Provenance string `json:"provenance,omitempty"`
+	// Sensitive is set for Secret variables, whose defaults are never described.
+	Sensitive bool `json:"sensitive,omitempty"`
+	// DefaultRedacted is set instead of Default for Sensitive variables with a default.
+	DefaultRedacted bool `json:"default_redacted,omitempty"`
+	// FileOK is set if the variable may instead be read from the file named by NAME_FILE.
+	FileOK bool `json:"file_ok,omitempty"`
+	// Group is the label of the Cfg.Group which extracted the variable, if any.
+	Group string `json:"group,omitempty"`
+	// Reloadable is set if changes to the variable take effect without a restart; see Cfg.Watch.
+	Reloadable bool `json:"reloadable,omitempty"`
 }
 
 type DefaultValDescription struct {
 	Value interface{}
+	// String is the default as it would be written in docOpts or the environment.
+	String string
 }
 
+// MarshalJSON marshals the value. Values which are pointers implementing fmt.Stringer without their own JSON encoding
+// -- e.g. *url.URL -- or slices of such pointers are marshalled as strings.
 func (d DefaultValDescription) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.Value)
+	return json.Marshal(jsonValue(d.Value))
+}
+
+// UnmarshalJSON unmarshals the value, e.g. from a snapshot of Describe; String is left empty, as it isn't marshalled.
+// Numbers are unmarshalled as json.Numbers, so that integers beyond the precision of a float64 survive.
+func (d *DefaultValDescription) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	return dec.Decode(&d.Value)
+}
+
+func jsonValue(v interface{}) interface{} {
+	switch v.(type) {
+	case json.Marshaler, encoding.TextMarshaler:
+		return v
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if s, ok := v.(fmt.Stringer); ok && !rv.IsNil() {
+			return s.String()
+		}
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Ptr {
+			vals := make([]interface{}, rv.Len())
+			for i := range vals {
+				vals[i] = jsonValue(rv.Index(i).Interface())
+			}
+			return vals
+		}
+	}
+	return v
 }
 
 func (c *Cfg) addDescription(desc Description) {
 	c.descriptions = append(c.descriptions, desc)
 }
 
-func (c *Cfg) evaluate(s *spec) interface{} {
-	val, err := s.evaluate(c.envFunc)
+// register describes and evaluates the spec, recording where its value came from.
+func (c *Cfg) register(s *spec) interface{} {
+	s.name = c.prefix + s.name
+	if c.fileOK {
+		s.flags |= flagFileOK
+	}
+	desc := s.describe()
+	desc.Group = c.group
+	val, provenance, err := s.evaluate(c.sources)
+	desc.Provenance = provenance
+	c.addDescription(desc)
+	c.watch(s, val)
 	if err != nil {
 		c.addError(err)
 	}
 	return val
 }
 
+// qualify prepends the prefix to the name in an error constructing a spec, which occurs before the spec is registered.
+func (c *Cfg) qualify(err error) error {
+	var docErr *DocOptsError
+	if errors.As(err, &docErr) && docErr.Name != "" {
+		docErr.Name = c.prefix + docErr.Name
+	}
+	return err
+}
+
 func (c *Cfg) addError(err error) {
 	if c.panic {
 		panic(err)
@@ -114,30 +250,81 @@ type spec struct {
 	comment        string
 }
 
-func (s *spec) evaluate(envFunc func(string) (string, bool)) (interface{}, error) {
-	v, ok := envFunc(s.name)
+func (s *spec) evaluate(ss sources) (interface{}, string, error) {
+	v, provenance, ok, err := s.lookup(ss)
+	if err != nil {
+		return nil, "", err
+	}
 	if !ok {
 		if s.flags&(flagDefaultVal|flagDefaultValString) > 0 {
-			return s.defaultVal, nil
+			return s.defaultVal, provenanceDefault, nil
 		}
 		if s.flags&flagOptional == 0 {
-			return nil, fmt.Errorf("%v: variable is required", s.name)
+			return nil, "", &MissingError{Name: s.name}
+		}
+		return nil, "", nil
+	}
+	val, err := s.parse(v)
+	if err != nil {
+		if s.flags&flagSecret > 0 {
+			return nil, provenance, &ParseError{Name: s.name, Err: &redactedError{err: err}, Sensitive: true}
 		}
-		return nil, nil
+		return nil, provenance, &ParseError{Name: s.name, Value: v, Err: err}
 	}
-	return s.parse(v)
+	return val, provenance, nil
+}
+
+// lookup returns the value from the first source setting the variable or, if it's FileOK, naming its file, so that a
+// file named in one source takes precedence over the variable itself in a later one.
+func (s *spec) lookup(ss sources) (val, provenance string, ok bool, err error) {
+	for _, src := range ss {
+		if val, ok = src.Lookup(s.name); ok {
+			return val, src.Name, true, nil
+		}
+		if s.flags&flagFileOK == 0 {
+			continue
+		}
+		if path, found := src.Lookup(s.name + fileSuffix); found {
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return "", "", false, &FileError{Name: s.name, Path: path, Err: err}
+			}
+			return trimNewline(string(b)), "file:" + path, true, nil
+		}
+	}
+	return "", "", false, nil
+}
+
+// fileSuffix is appended to the name of a FileOK variable to find the variable naming its file.
+const fileSuffix = "_FILE"
+
+// trimNewline removes a single trailing newline, as written by most editors and by echo.
+func trimNewline(s string) string {
+	if strings.HasSuffix(s, "\n") {
+		s = strings.TrimSuffix(strings.TrimSuffix(s, "\n"), "\r")
+	}
+	return s
 }
 
 func (s *spec) describe() Description {
 	desc := Description{
-		Name:     s.name,
-		Type:     s.typeName,
-		Optional: s.flags&flagOptional > 0,
-		Comment:  s.comment,
-		Params:   s.parser.describe(),
-	}
-	if s.flags&flagDefaultVal > 0 {
-		desc.Default = &DefaultValDescription{s.defaultVal}
+		Name:       s.name,
+		Type:       s.typeName,
+		Optional:   s.flags&flagOptional > 0,
+		Comment:    s.comment,
+		Params:     s.parser.describe(),
+		FileOK:     s.flags&flagFileOK > 0,
+		Reloadable: s.flags&flagReloadable > 0,
+	}
+	if s.flags&flagSecret > 0 {
+		desc.Sensitive = true
+		desc.DefaultRedacted = s.flags&(flagDefaultVal|flagDefaultValString) > 0
+		return desc
+	}
+	if s.flags&flagDefaultValString > 0 {
+		desc.Default = &DefaultValDescription{Value: s.defaultVal, String: s.defaultValS}
+	} else if s.flags&flagDefaultVal > 0 {
+		desc.Default = &DefaultValDescription{Value: s.defaultVal, String: s.format(s.defaultVal)}
 	}
 	return desc
 }
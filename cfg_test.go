@@ -2,7 +2,11 @@ package envcfg_test
 
 import (
 	"encoding/json"
+	"errors"
+	"net"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -29,7 +33,8 @@ func TestConfigure(t *testing.T) {
 	"name": "a",
 	"type": "int64",
 	"optional": false,
-	"params": {}
+	"params": {},
+	"provenance": "env"
 }`,
 		},
 		{
@@ -57,7 +62,8 @@ func TestConfigure(t *testing.T) {
 	"type": "int64",
 	"optional": false,
 	"default": 2,
-	"params": {}
+	"params": {},
+	"provenance": "default"
 }`,
 		},
 		{
@@ -71,7 +77,8 @@ func TestConfigure(t *testing.T) {
 	"type": "int64",
 	"optional": false,
 	"default": 2,
-	"params": {}
+	"params": {},
+	"provenance": "default"
 }`,
 		},
 		{
@@ -87,7 +94,8 @@ func TestConfigure(t *testing.T) {
 	"default": 21,
 	"params": {
 		"base": 2
-	}
+	},
+	"provenance": "default"
 }`,
 		},
 		{
@@ -104,7 +112,8 @@ func TestConfigure(t *testing.T) {
 	"params": {
 		"base": 2,
 		"bit_size": 32
-	}
+	},
+	"provenance": "default"
 }`,
 		},
 		{
@@ -118,7 +127,8 @@ func TestConfigure(t *testing.T) {
 	"type": "int64",
 	"optional": false,
 	"default": 10,
-	"params": {}
+	"params": {},
+	"provenance": "default"
 }`,
 		},
 		{
@@ -146,7 +156,8 @@ func TestConfigure(t *testing.T) {
 	"type": "bool",
 	"optional": false,
 	"default": true,
-	"params": {}
+	"params": {},
+	"provenance": "default"
 }`,
 		},
 		{
@@ -160,7 +171,8 @@ func TestConfigure(t *testing.T) {
 	"type": "bool",
 	"optional": false,
 	"default": false,
-	"params": {}
+	"params": {},
+	"provenance": "default"
 }`,
 		},
 		{
@@ -188,7 +200,8 @@ func TestConfigure(t *testing.T) {
 	"name": "a",
 	"type": "float64",
 	"optional": false,
-	"params": {"bit_size": 32}
+	"params": {"bit_size": 32},
+	"provenance": "env"
 }`,
 		},
 		{
@@ -202,7 +215,8 @@ func TestConfigure(t *testing.T) {
 	"type": "float64",
 	"optional": false,
 	"default": 19,
-	"params": {"bit_size": 32}
+	"params": {"bit_size": 32},
+	"provenance": "default"
 }`,
 		},
 		{
@@ -216,7 +230,8 @@ func TestConfigure(t *testing.T) {
 	"name": "a",
 	"type": "time.Duration",
 	"optional": false,
-	"params": {}
+	"params": {},
+	"provenance": "env"
 }`,
 		},
 		{
@@ -230,7 +245,8 @@ func TestConfigure(t *testing.T) {
 	"type": "time.Duration",
 	"default": 15000000,
 	"optional": false,
-	"params": {}
+	"params": {},
+	"provenance": "default"
 }`,
 		},
 		{
@@ -244,7 +260,8 @@ func TestConfigure(t *testing.T) {
 	"name": "a",
 	"type": "time.Time",
 	"optional": false,
-	"params": {}
+	"params": {},
+	"provenance": "env"
 }`,
 		},
 		{
@@ -258,7 +275,8 @@ func TestConfigure(t *testing.T) {
 	"name": "a",
 	"type": "time.Time",
 	"optional": false,
-	"params": {"layout": "2006-01-02T15:04Z07:00"}
+	"params": {"layout": "2006-01-02T15:04Z07:00"},
+	"provenance": "env"
 }`,
 		},
 		{
@@ -272,7 +290,8 @@ func TestConfigure(t *testing.T) {
 	"name": "a",
 	"type": "[]int64",
 	"optional": false,
-	"params": {}
+	"params": {},
+	"provenance": "env"
 }`,
 		},
 		{
@@ -286,7 +305,8 @@ func TestConfigure(t *testing.T) {
 	"name": "a",
 	"type": "[]int64",
 	"optional": false,
-	"params": {"comma": " ", "base": 16}
+	"params": {"comma": " ", "base": 16},
+	"provenance": "env"
 }`,
 		},
 		{
@@ -300,7 +320,8 @@ func TestConfigure(t *testing.T) {
 	"name": "a",
 	"type": "[]int64",
 	"optional": false,
-	"params": {"comma": " ", "base": 16}
+	"params": {"comma": " ", "base": 16},
+	"provenance": "env"
 }`,
 		},
 		{
@@ -314,7 +335,8 @@ func TestConfigure(t *testing.T) {
 	"name": "a",
 	"type": "uint64",
 	"optional": false,
-	"params": {}
+	"params": {},
+	"provenance": "env"
 }`,
 		},
 		{
@@ -342,7 +364,8 @@ func TestConfigure(t *testing.T) {
 	"type": "uint64",
 	"optional": false,
 	"default": 2,
-	"params": {}
+	"params": {},
+	"provenance": "default"
 }`,
 		},
 		{
@@ -356,7 +379,557 @@ func TestConfigure(t *testing.T) {
 	"type": "uint64",
 	"optional": false,
 	"default": 2,
-	"params": {}
+	"params": {},
+	"provenance": "default"
+}`,
+		},
+		{
+			name: "int bounds",
+			env:  map[string]string{"a": "80"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.Int("a min=1 max=0xffff")
+			},
+			expectedVal: int64(80),
+			expectedDescription: `{
+	"name": "a",
+	"type": "int64",
+	"optional": false,
+	"params": {"min": "1", "max": "65535"},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "int out of bounds",
+			env:  map[string]string{"a": "70000"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.Int("a base=16 default=50", envcfg.IntMin(1), envcfg.IntMax(0xffff))
+			},
+			expectedVal: int64(0),
+			wantErr:     `a: invalid value "70000": must be at most ffff`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "int64",
+	"optional": false,
+	"default": 80,
+	"params": {"base": 16, "min": "1", "max": "ffff"},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "typed bounds take precedence",
+			env:  map[string]string{"a": "5"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.Int("a min=10 max=20", envcfg.IntMin(1))
+			},
+			expectedVal: int64(5),
+			expectedDescription: `{
+	"name": "a",
+	"type": "int64",
+	"optional": false,
+	"params": {"min": "1", "max": "20"},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "duration below min",
+			env:  map[string]string{"a": "10ms"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.Duration("a min=1s")
+			},
+			expectedVal: time.Duration(0),
+			wantErr:     `a: invalid value "10ms": must be at least 1s`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "time.Duration",
+	"optional": false,
+	"params": {"min": "1s"},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "string oneof",
+			env:  map[string]string{"a": "warn"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.String("a oneof=debug,info,warn default=info")
+			},
+			expectedVal: "warn",
+			expectedDescription: `{
+	"name": "a",
+	"type": "string",
+	"optional": false,
+	"default": "info",
+	"params": {"oneof": ["debug", "info", "warn"]},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "string not oneof",
+			env:  map[string]string{"a": "loud"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.String("a", envcfg.StringOneOf([]string{"debug", "info"}))
+			},
+			expectedVal: "",
+			wantErr:     `a: invalid value "loud": must be one of debug,info`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "string",
+	"optional": false,
+	"params": {"oneof": ["debug", "info"]},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "string regex",
+			env:  map[string]string{"a": "abc1"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.String("a regex=^[a-z]+$")
+			},
+			expectedVal: "",
+			wantErr:     `a: invalid value "abc1": must match ^[a-z]+$`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "string",
+	"optional": false,
+	"params": {"regex": "^[a-z]+$"},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "string slice length",
+			env:  map[string]string{"a": "x,y,z"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.StringSlice("a min_len=1 max_len=2")
+			},
+			expectedVal: []string(nil),
+			wantErr:     `a: invalid value "x,y,z": length must be at most 2`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "[]string",
+	"optional": false,
+	"params": {"min_len": 1, "max_len": 2},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "bytes length",
+			env:  map[string]string{"a": "AAEC"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.Bytes("a min_len=3", envcfg.BytesMaxLen(3))
+			},
+			expectedVal: []byte{0, 1, 2},
+			expectedDescription: `{
+	"name": "a",
+	"type": "[]byte",
+	"optional": false,
+	"params": {"min_len": 3, "max_len": 3},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "ip net slice",
+			env:  map[string]string{"a": "10.0.0.0/8,192.168.0.0/16"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.IPNetSlice("a ipv4")
+			},
+			expectedVal: []*net.IPNet{
+				{IP: net.IP{10, 0, 0, 0}, Mask: net.CIDRMask(8, 32)},
+				{IP: net.IP{192, 168, 0, 0}, Mask: net.CIDRMask(16, 32)},
+			},
+			expectedDescription: `{
+	"name": "a",
+	"type": "[]*net.IPNet",
+	"optional": false,
+	"params": {"ipv4": true},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "ip net default",
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.IPNet("a default=fd00::/8")
+			},
+			expectedVal: &net.IPNet{IP: net.ParseIP("fd00::"), Mask: net.CIDRMask(8, 128)},
+			expectedDescription: `{
+	"name": "a",
+	"type": "*net.IPNet",
+	"optional": false,
+	"default": "fd00::/8",
+	"params": {},
+	"provenance": "default"
+}`,
+		},
+		{
+			name: "ip slice wrong family",
+			env:  map[string]string{"a": "::1,10.0.0.1"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.IPSlice("a", envcfg.IPSliceIPv6(true))
+			},
+			expectedVal: []net.IP(nil),
+			wantErr:     `a: invalid value "::1,10.0.0.1": 1 index: must be an IPv6 address`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "[]net.IP",
+	"optional": false,
+	"params": {"ipv6": true},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "string map",
+			env:  map[string]string{"a": `X-Tenant=acme,"X-List=a,b"`},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.StringMap("a")
+			},
+			expectedVal: map[string]string{"X-Tenant": "acme", "X-List": "a,b"},
+			expectedDescription: `{
+	"name": "a",
+	"type": "map[string]string",
+	"optional": false,
+	"params": {},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "int map separators",
+			env:  map[string]string{"a": "acme:10;globex:0x20"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.IntMap("a comma=; key_value_sep=:", envcfg.IntMapBase(0))
+			},
+			expectedVal: map[string]int64{"acme": 10, "globex": 32},
+			expectedDescription: `{
+	"name": "a",
+	"type": "map[string]int64",
+	"optional": false,
+	"params": {"comma": ";", "key_value_sep": ":"},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "duration map default",
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.DurationMap("a", envcfg.DurationMapDefault(map[string]time.Duration{"b": time.Minute, "a": time.Second}))
+			},
+			expectedVal: map[string]time.Duration{"a": time.Second, "b": time.Minute},
+			expectedDescription: `{
+	"name": "a",
+	"type": "map[string]time.Duration",
+	"optional": false,
+	"default": {"a": 1000000000, "b": 60000000000},
+	"params": {},
+	"provenance": "default"
+}`,
+		},
+		{
+			name: "duration map bad value",
+			env:  map[string]string{"a": "a=1s,b=soon"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.DurationMap("a")
+			},
+			expectedVal: map[string]time.Duration(nil),
+			wantErr:     `a: invalid value "a=1s,b=soon": b key: time: invalid duration "soon"`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "map[string]time.Duration",
+	"optional": false,
+	"params": {},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "string map duplicate key",
+			env:  map[string]string{"a": "a=1,a=2"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.StringMap("a")
+			},
+			expectedVal: map[string]string(nil),
+			wantErr:     `a: invalid value "a=1,a=2": 1 index: duplicate key "a"`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "map[string]string",
+	"optional": false,
+	"params": {},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "byte size",
+			env:  map[string]string{"a": "1.5GiB"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.ByteSize("a min=1MB max=2GiB")
+			},
+			expectedVal: envcfg.ByteSize(3 << 29),
+			expectedDescription: `{
+	"name": "a",
+	"type": "ByteSize",
+	"optional": false,
+	"params": {"min": "1MB", "max": "2GiB"},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "byte size iec default",
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.ByteSize("a iec default=64K")
+			},
+			expectedVal: envcfg.ByteSize(64 << 10),
+			expectedDescription: `{
+	"name": "a",
+	"type": "ByteSize",
+	"optional": false,
+	"default": 65536,
+	"params": {"iec": true},
+	"provenance": "default"
+}`,
+		},
+		{
+			name: "byte size too small",
+			env:  map[string]string{"a": "512KB"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.ByteSize("a", envcfg.ByteSizeMin(1<<20))
+			},
+			expectedVal: envcfg.ByteSize(0),
+			wantErr:     `a: invalid value "512KB": must be at least 1MiB`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "ByteSize",
+	"optional": false,
+	"params": {"min": "1MiB"},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "duration slice",
+			env:  map[string]string{"a": "1s,5s,30s"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.DurationSlice("a max_len=3")
+			},
+			expectedVal: []time.Duration{time.Second, 5 * time.Second, 30 * time.Second},
+			expectedDescription: `{
+	"name": "a",
+	"type": "[]time.Duration",
+	"optional": false,
+	"params": {"max_len": 3},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "float slice index error",
+			env:  map[string]string{"a": "0.2,x"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.FloatSlice("a")
+			},
+			expectedVal: []float64(nil),
+			wantErr:     `a: invalid value "0.2,x": 1 index: strconv.ParseFloat: parsing "x": invalid syntax`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "[]float64",
+	"optional": false,
+	"params": {},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "uint slice",
+			env:  map[string]string{"a": "ff:10"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.UintSlice("a base=16 comma=:")
+			},
+			expectedVal: []uint64{255, 16},
+			expectedDescription: `{
+	"name": "a",
+	"type": "[]uint64",
+	"optional": false,
+	"params": {"base": 16, "comma": ":"},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "bool slice default",
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.BoolSlice("a default=true,f")
+			},
+			expectedVal: []bool{true, false},
+			expectedDescription: `{
+	"name": "a",
+	"type": "[]bool",
+	"optional": false,
+	"default": [true, false],
+	"params": {},
+	"provenance": "default"
+}`,
+		},
+		{
+			name: "time slice",
+			env:  map[string]string{"a": "2020-01-02,2021-03-04"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.TimeSlice("a", envcfg.TimeSliceLayout("2006-01-02"))
+			},
+			expectedVal: []time.Time{
+				time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC),
+			},
+			expectedDescription: `{
+	"name": "a",
+	"type": "[]time.Time",
+	"optional": false,
+	"params": {"layout": "2006-01-02"},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "secret default",
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.String("a secret default=hunter2")
+			},
+			expectedVal: "hunter2",
+			expectedDescription: `{
+	"name": "a",
+	"type": "string",
+	"optional": false,
+	"params": {},
+	"provenance": "default",
+	"sensitive": true,
+	"default_redacted": true
+}`,
+		},
+		{
+			name: "secret invalid",
+			env:  map[string]string{"a": "hunter2"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.String("a regex=^[a-z]+$", envcfg.Secret)
+			},
+			expectedVal: "",
+			wantErr:     `a: invalid value: must match ^[a-z]+$`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "string",
+	"optional": false,
+	"params": {"regex": "^[a-z]+$"},
+	"provenance": "env",
+	"sensitive": true
+}`,
+		},
+		{
+			name: "url",
+			env:  map[string]string{"a": "postgres://db.internal:5432/app"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.URL("a schemes=https,postgres require_host")
+			},
+			expectedVal: &url.URL{Scheme: "postgres", Host: "db.internal:5432", Path: "/app"},
+			expectedDescription: `{
+	"name": "a",
+	"type": "*url.URL",
+	"optional": false,
+	"params": {"schemes": ["https", "postgres"], "require_host": true},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "url default",
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.URL("a default=https://example.com/")
+			},
+			expectedVal: &url.URL{Scheme: "https", Host: "example.com", Path: "/"},
+			expectedDescription: `{
+	"name": "a",
+	"type": "*url.URL",
+	"optional": false,
+	"default": "https://example.com/",
+	"params": {},
+	"provenance": "default"
+}`,
+		},
+		{
+			name: "url bad scheme",
+			env:  map[string]string{"a": "http://example.com"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.URL("a", envcfg.URLSchemes([]string{"https"}))
+			},
+			expectedVal: (*url.URL)(nil),
+			wantErr:     `a: invalid value "http://example.com": scheme must be one of https`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "*url.URL",
+	"optional": false,
+	"params": {"schemes": ["https"]},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "url no host",
+			env:  map[string]string{"a": "/just/a/path"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.URL("a require_host=true")
+			},
+			expectedVal: (*url.URL)(nil),
+			wantErr:     `a: invalid value "/just/a/path": host is required`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "*url.URL",
+	"optional": false,
+	"params": {"require_host": true},
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "custom",
+			env:  map[string]string{"a": "WARN"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.Custom("a | the log level", levelParser{"debug", "info", "warn"})
+			},
+			expectedVal: 2,
+			expectedDescription: `{
+	"name": "a",
+	"type": "level",
+	"optional": false,
+	"params": {"levels": ["debug", "info", "warn"]},
+	"comment": "the log level",
+	"provenance": "env"
+}`,
+		},
+		{
+			name: "custom default string",
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.Custom("a default=info", levelParser{"debug", "info", "warn"})
+			},
+			expectedVal: 1,
+			expectedDescription: `{
+	"name": "a",
+	"type": "level",
+	"optional": false,
+	"default": 1,
+	"params": {"levels": ["debug", "info", "warn"]},
+	"provenance": "default"
+}`,
+		},
+		{
+			name: "custom default opt",
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.Custom("a", levelParser{"debug", "info", "warn"}, envcfg.CustomDefault(2))
+			},
+			expectedVal: 2,
+			expectedDescription: `{
+	"name": "a",
+	"type": "level",
+	"optional": false,
+	"default": 2,
+	"params": {"levels": ["debug", "info", "warn"]},
+	"provenance": "default"
+}`,
+		},
+		{
+			name: "custom invalid",
+			env:  map[string]string{"a": "loud"},
+			configureFunc: func(cfg *envcfg.Cfg) interface{} {
+				return cfg.Custom("a optional", levelParser{"debug", "info", "warn"})
+			},
+			wantErr: `a: invalid value "loud": unknown level`,
+			expectedDescription: `{
+	"name": "a",
+	"type": "level",
+	"optional": true,
+	"params": {"levels": ["debug", "info", "warn"]},
+	"provenance": "env"
 }`,
 		},
 	}
@@ -417,3 +990,68 @@ func TestConfigure(t *testing.T) {
 
 	}
 }
+
+type levelParser []string
+
+func (levelParser) TypeName() string {
+	return "level"
+}
+
+func (p levelParser) Parse(s string) (interface{}, error) {
+	for i, l := range p {
+		if strings.EqualFold(l, s) {
+			return i, nil
+		}
+	}
+	return nil, errors.New("unknown level")
+}
+
+func (p levelParser) Describe() interface{} {
+	return map[string][]string{"levels": p}
+}
+
+func TestCfg_Prefix(t *testing.T) {
+	poolSize := func(c *envcfg.Cfg) int64 {
+		return c.Int("POOL_SIZE default=4")
+	}
+
+	c := envcfg.New(envcfg.Panic(false), envcfg.EnvFunc(func(s string) (string, bool) {
+		v, ok := map[string]string{"PAYMENTS_POOL_SIZE": "8", "ORDERS_DB_HOST": "db"}[s]
+		return v, ok
+	}))
+	payments := c.Prefix("PAYMENTS_").Group("payments")
+	orders := c.Prefix("ORDERS_")
+	got := []interface{}{
+		poolSize(payments),
+		poolSize(orders),
+		orders.Prefix("DB_").String("HOST"),
+		orders.Has("DB_HOST"),
+	}
+	_ = payments.String("KEY")
+	_ = payments.Int("BAD bass=16")
+
+	if want := []interface{}{int64(8), int64(4), "db", true}; !reflect.DeepEqual(want, got) {
+		t.Errorf("Prefix() values = %v, want %v", got, want)
+	}
+
+	var names, groups []string
+	for _, d := range c.Describe() {
+		names = append(names, d.Name)
+		groups = append(groups, d.Group)
+	}
+	want := []string{"PAYMENTS_POOL_SIZE", "ORDERS_POOL_SIZE", "ORDERS_DB_HOST", "ORDERS_DB_HOST", "PAYMENTS_KEY"}
+	if !reflect.DeepEqual(want, names) {
+		t.Errorf("Prefix() names = %v, want %v", names, want)
+	}
+	if want := []string{"payments", "", "", "", "payments"}; !reflect.DeepEqual(want, groups) {
+		t.Errorf("Group() groups = %q, want %q", groups, want)
+	}
+
+	wantErr := `2 errors: PAYMENTS_KEY: variable is required; PAYMENTS_BAD: invalid option "bass": unknown option`
+	if err := c.Err(); err == nil || err.Error() != wantErr {
+		t.Errorf("Prefix() err = %v, want %v", err, wantErr)
+	}
+	if err := payments.Err(); err == nil || err.Error() != wantErr {
+		t.Errorf("Prefix() child err = %v, want %v", err, wantErr)
+	}
+}
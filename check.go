@@ -0,0 +1,54 @@
+package envcfg
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CheckDocOpts checks a docOpts string as the Cfg method named -- e.g. "Int" -- would, without extracting anything,
+// returning the variable's name or the *DocOptsError the method would report, e.g. for an unknown option or a default
+// which doesn't parse. It allows docOpts to be checked statically; see the envcfgvet analyzer. Calls which also pass typed
+// options should be checked with CheckDocOptsKeys.
+func CheckDocOpts(method, docOpts string) (name string, err error) {
+	newSpec, ok := specs[method]
+	if !ok {
+		return "", fmt.Errorf("no Cfg method %q accepting docOpts", method)
+	}
+	s, err := newSpec(docOpts)
+	if err != nil {
+		return "", err
+	}
+	return s.name, nil
+}
+
+// CheckDocOptsKeys checks only that the docOpts string parses and that the Cfg method named accepts each of its keys,
+// returning the variable's name or the *DocOptsError the method would report. Unlike CheckDocOpts, it doesn't check the
+// values, so it may be used for calls which also pass typed options -- e.g. IntBase(16) -- on which the validity of
+// the default and other values may depend.
+func CheckDocOptsKeys(method, docOpts string) (name string, err error) {
+	keys, ok := docOptsKeys[method]
+	if !ok {
+		return "", fmt.Errorf("no Cfg method %q accepting docOpts", method)
+	}
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return "", &DocOptsError{Err: err}
+	}
+	for _, f := range parsed.fields {
+		if !containsString(keys, strings.ToLower(f[0])) {
+			return "", &DocOptsError{Name: parsed.name, Key: f[0], Err: ErrUnknownOption}
+		}
+	}
+	return parsed.name, nil
+}
+
+// DocOptsMethods returns the names of the Cfg methods whose docOpts CheckDocOpts can check.
+func DocOptsMethods() []string {
+	methods := make([]string, 0, len(specs))
+	for m := range specs {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	return methods
+}
@@ -0,0 +1,62 @@
+package envcfg_test
+
+import (
+	"testing"
+
+	"github.com/jwilner/envcfg"
+)
+
+func TestCheckDocOpts(t *testing.T) {
+	tests := []struct {
+		method, docOpts, wantName, wantErr string
+	}{
+		{method: "Int", docOpts: "PORT base=16 default=1f | The port", wantName: "PORT"},
+		{
+			method:  "Int",
+			docOpts: "PORT default=zz base=10",
+			wantErr: `PORT: invalid option "default": strconv.ParseInt: parsing "zz": invalid syntax`,
+		},
+		{method: "Time", docOpts: "STARTS lyout=2006", wantErr: `STARTS: invalid option "lyout": unknown option`},
+		{method: "IntSlice", docOpts: "IDS comma=ab", wantErr: `IDS: invalid option "comma": must be only one rune`},
+		{method: "String", docOpts: "", wantErr: "invalid docOpts: doc must contain at least name"},
+		{method: "Custom", docOpts: "LEVEL", wantErr: `no Cfg method "Custom" accepting docOpts`},
+	}
+	for _, tt := range tests {
+		name, err := envcfg.CheckDocOpts(tt.method, tt.docOpts)
+		var gotErr string
+		if err != nil {
+			gotErr = err.Error()
+		}
+		if name != tt.wantName || gotErr != tt.wantErr {
+			t.Errorf("CheckDocOpts(%q, %q) = %q, %q, want %q, %q",
+				tt.method, tt.docOpts, name, gotErr, tt.wantName, tt.wantErr)
+		}
+	}
+
+	// defaults and values may depend on typed options, so only the keys are checked
+	keysTests := []struct {
+		method, docOpts, wantName, wantErr string
+	}{
+		{method: "Int", docOpts: "A default=ff", wantName: "A"},
+		{method: "Uint", docOpts: "A MIN=ff max=fff", wantName: "A"},
+		{method: "Int", docOpts: "A bass=16", wantErr: `A: invalid option "bass": unknown option`},
+		{method: "String", docOpts: "", wantErr: "invalid docOpts: doc must contain at least name"},
+		{method: "Custom", docOpts: "LEVEL", wantErr: `no Cfg method "Custom" accepting docOpts`},
+	}
+	for _, tt := range keysTests {
+		name, err := envcfg.CheckDocOptsKeys(tt.method, tt.docOpts)
+		var gotErr string
+		if err != nil {
+			gotErr = err.Error()
+		}
+		if name != tt.wantName || gotErr != tt.wantErr {
+			t.Errorf("CheckDocOptsKeys(%q, %q) = %q, %q, want %q, %q",
+				tt.method, tt.docOpts, name, gotErr, tt.wantName, tt.wantErr)
+		}
+	}
+
+	methods := envcfg.DocOptsMethods()
+	if len(methods) == 0 || methods[0] != "Bool" {
+		t.Errorf("DocOptsMethods() = %v", methods)
+	}
+}
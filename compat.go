@@ -0,0 +1,160 @@
+package envcfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Difference is a difference between two versions of a configuration interface found by Compare.
+type Difference struct {
+	Name    string
+	Message string
+	// Breaking is set if an environment valid for the old interface may be invalid, or interpreted differently, under
+	// the new one.
+	Breaking bool
+}
+
+func (d Difference) String() string {
+	kind := "non-breaking"
+	if d.Breaking {
+		kind = "breaking"
+	}
+	return fmt.Sprintf("%v: %v (%v)", d.Name, d.Message, kind)
+}
+
+// Compare compares a previous version of a configuration interface -- e.g. a snapshot of Describe committed as JSON --
+// with the current one, classifying each difference as breaking or not. Since the defaults of Sensitive variables aren't
+// described, only whether a variable has a default is compared while it's Sensitive in either version.
+//
+// Removing a variable, adding a required one, making one required, or changing the type, params or an existing default
+// of one are breaking, as is no longer allowing a variable to be read from a file. Adding an optional variable or a
+// default, making one optional, and changes to comments, groups and other annotations are not.
+func Compare(prev, cur []Description) []Difference {
+	var (
+		diffs      []Difference
+		prevByName = make(map[string]Description, len(prev))
+		curNames   = make(map[string]bool, len(cur))
+	)
+	for _, d := range prev {
+		prevByName[d.Name] = d
+	}
+	add := func(name string, breaking bool, format string, args ...interface{}) {
+		diffs = append(diffs, Difference{Name: name, Message: fmt.Sprintf(format, args...), Breaking: breaking})
+	}
+
+	for _, c := range cur {
+		curNames[c.Name] = true
+		p, ok := prevByName[c.Name]
+		if !ok {
+			if required(c) {
+				add(c.Name, true, "added required variable")
+			} else {
+				add(c.Name, false, "added optional variable")
+			}
+			continue
+		}
+
+		if p.Type != c.Type {
+			add(c.Name, true, "type changed from %v to %v", p.Type, c.Type)
+		}
+		if prevParams, curParams := canonicalJSON(p.Params), canonicalJSON(c.Params); prevParams != curParams {
+			add(c.Name, true, "params changed from %v to %v", prevParams, curParams)
+		}
+		switch {
+		case p.Sensitive || c.Sensitive:
+			switch prevHas, curHas := hasDefault(p), hasDefault(c); {
+			case prevHas && !curHas:
+				add(c.Name, true, "removed default")
+			case !prevHas && curHas:
+				add(c.Name, false, "added default")
+			}
+		default:
+			switch prevDefault, curDefault := defaultJSON(p), defaultJSON(c); {
+			case prevDefault == curDefault:
+			case prevDefault == "":
+				add(c.Name, false, "added default %v", curDefault)
+			case curDefault == "":
+				add(c.Name, true, "removed default %v", prevDefault)
+			default:
+				add(c.Name, true, "default changed from %v to %v", prevDefault, curDefault)
+			}
+		}
+		if p.Optional != c.Optional {
+			if c.Optional {
+				add(c.Name, false, "made optional")
+			} else {
+				add(c.Name, true, "made required")
+			}
+		}
+		if p.FileOK != c.FileOK {
+			add(c.Name, !c.FileOK, "file_ok changed from %v to %v", p.FileOK, c.FileOK)
+		}
+		if p.Comment != c.Comment {
+			add(c.Name, false, "comment changed from %q to %q", p.Comment, c.Comment)
+		}
+		if p.Group != c.Group {
+			add(c.Name, false, "group changed from %q to %q", p.Group, c.Group)
+		}
+		if p.Sensitive != c.Sensitive {
+			add(c.Name, false, "secret changed from %v to %v", p.Sensitive, c.Sensitive)
+		}
+		if p.Reloadable != c.Reloadable {
+			add(c.Name, false, "reloadable changed from %v to %v", p.Reloadable, c.Reloadable)
+		}
+	}
+
+	for _, p := range prev {
+		if !curNames[p.Name] {
+			add(p.Name, true, "removed variable")
+		}
+	}
+	return diffs
+}
+
+// Breaking returns the breaking differences.
+func Breaking(diffs []Difference) []Difference {
+	var breaking []Difference
+	for _, d := range diffs {
+		if d.Breaking {
+			breaking = append(breaking, d)
+		}
+	}
+	return breaking
+}
+
+func required(d Description) bool {
+	return !d.Optional && !hasDefault(d)
+}
+
+func hasDefault(d Description) bool {
+	return d.Default != nil || d.DefaultRedacted
+}
+
+// defaultJSON returns the canonical JSON of the description's default, or empty if it has none. Defaults are compared as
+// JSON since descriptions read from a snapshot only have the JSON values.
+func defaultJSON(d Description) string {
+	if d.Default == nil {
+		return ""
+	}
+	return canonicalJSON(d.Default)
+}
+
+// canonicalJSON marshals the value, round tripping it through a generic value so that e.g. structs and maps with the
+// same fields compare equal.
+func canonicalJSON(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	var generic interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&generic); err != nil {
+		return string(b)
+	}
+	if b, err = json.Marshal(generic); err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
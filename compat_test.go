@@ -0,0 +1,158 @@
+package envcfg_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/jwilner/envcfg"
+)
+
+func TestCompare(t *testing.T) {
+	describe := func(configure func(c *envcfg.Cfg)) []envcfg.Description {
+		c := envcfg.New(envcfg.Panic(false), envcfg.EnvFunc(func(string) (string, bool) { return "", false }))
+		configure(c)
+		return c.Describe()
+	}
+
+	prev := describe(func(c *envcfg.Cfg) {
+		_ = c.Int("PORT default=8080 | The port")
+		_ = c.Duration("TIMEOUT default=5s")
+		_ = c.IntSlice("IDS base=16")
+		_ = c.String("NAME")
+		_ = c.String("REGION optional")
+		_ = c.String("ZONE")
+		_ = c.String("PASSWORD file_ok")
+		_ = c.String("REMOVED")
+	})
+	// compare against a snapshot, as it would be committed
+	b, err := json.Marshal(prev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev = nil
+	if err := json.Unmarshal(b, &prev); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		cur := describe(func(c *envcfg.Cfg) {
+			_ = c.Int("PORT", envcfg.IntDefault(8080), envcfg.Comment("The port"))
+			_ = c.Duration("TIMEOUT", envcfg.DurationDefault(5e9))
+			_ = c.IntSlice("IDS base=16")
+			_ = c.String("NAME")
+			_ = c.String("REGION optional")
+			_ = c.String("ZONE")
+			_ = c.String("PASSWORD file_ok")
+			_ = c.String("REMOVED")
+		})
+		if diffs := envcfg.Compare(prev, cur); len(diffs) != 0 {
+			t.Errorf("Compare() = %v, want none", diffs)
+		}
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		cur := describe(func(c *envcfg.Cfg) {
+			_ = c.Int("PORT default=8080 | The port to listen on")
+			_ = c.Duration("TIMEOUT default=10s")
+			_ = c.UintSlice("IDS base=10")
+			_ = c.String("NAME default=app")
+			_ = c.String("REGION")
+			_ = c.String("ZONE optional")
+			_ = c.String("PASSWORD")
+			_ = c.String("ADDED")
+			_ = c.String("ADDED_OPTIONAL optional")
+		})
+		want := []envcfg.Difference{
+			{Name: "PORT", Message: `comment changed from "The port" to "The port to listen on"`},
+			{Name: "TIMEOUT", Message: "default changed from 5000000000 to 10000000000", Breaking: true},
+			{Name: "IDS", Message: "type changed from []int64 to []uint64", Breaking: true},
+			{Name: "IDS", Message: `params changed from {"base":16} to {"base":10}`, Breaking: true},
+			{Name: "NAME", Message: `added default "app"`},
+			{Name: "REGION", Message: "made required", Breaking: true},
+			{Name: "ZONE", Message: "made optional"},
+			{Name: "PASSWORD", Message: "file_ok changed from true to false", Breaking: true},
+			{Name: "ADDED", Message: "added required variable", Breaking: true},
+			{Name: "ADDED_OPTIONAL", Message: "added optional variable"},
+			{Name: "REMOVED", Message: "removed variable", Breaking: true},
+		}
+		diffs := envcfg.Compare(prev, cur)
+		if !reflect.DeepEqual(want, diffs) {
+			t.Errorf("Compare() got\n%v\nwant\n%v", diffs, want)
+		}
+		if got := len(envcfg.Breaking(diffs)); got != 7 {
+			t.Errorf("Breaking() returned %v differences, want 7", got)
+		}
+	})
+}
+
+// snapshot round trips the descriptions through JSON, as a committed snapshot would be.
+func snapshot(t *testing.T, descs []envcfg.Description) []envcfg.Description {
+	t.Helper()
+	b, err := json.Marshal(descs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var unmarshalled []envcfg.Description
+	if err := json.Unmarshal(b, &unmarshalled); err != nil {
+		t.Fatal(err)
+	}
+	return unmarshalled
+}
+
+func TestCompare_largeDefaults(t *testing.T) {
+	c := envcfg.New(envcfg.EnvFunc(func(string) (string, bool) { return "", false }))
+	_ = c.Int("FOREVER default=9223372036854775807")
+	_ = c.Uint("MAX default=18446744073709551615")
+	_ = c.Int("ODD default=9007199254740993")
+	_ = c.FloatSlice("RATIOS default=0.1,1e300")
+
+	prev := snapshot(t, c.Describe())
+	if got := prev[0].Default.Value; got != json.Number("9223372036854775807") {
+		t.Errorf("unmarshalled default = %#v, want the exact number", got)
+	}
+	if diffs := envcfg.Compare(prev, c.Describe()); len(diffs) != 0 {
+		t.Errorf("Compare() = %v, want none", diffs)
+	}
+}
+
+func TestCompare_secret(t *testing.T) {
+	describe := func(configure func(c *envcfg.Cfg)) []envcfg.Description {
+		c := envcfg.New(envcfg.EnvFunc(func(string) (string, bool) { return "", false }))
+		configure(c)
+		return c.Describe()
+	}
+
+	prev := snapshot(t, describe(func(c *envcfg.Cfg) {
+		_ = c.String("PASSWORD default=changeme")
+		_ = c.String("TOKEN secret default=dev")
+		_ = c.String("API_KEY secret default=dev")
+		_ = c.String("SALT secret")
+	}))
+	cur := describe(func(c *envcfg.Cfg) {
+		_ = c.String("PASSWORD secret default=changeme")
+		_ = c.String("TOKEN secret default=dev")
+		_ = c.String("API_KEY secret default=test")
+		_ = c.String("SALT secret default=salt")
+		_ = c.String("PEPPER secret default=pepper")
+	})
+	want := []envcfg.Difference{
+		{Name: "PASSWORD", Message: "secret changed from false to true"},
+		{Name: "SALT", Message: "added default"},
+		{Name: "PEPPER", Message: "added optional variable"},
+	}
+	if diffs := envcfg.Compare(prev, cur); !reflect.DeepEqual(want, diffs) {
+		t.Errorf("Compare() got\n%v\nwant\n%v", diffs, want)
+	}
+
+	want = []envcfg.Difference{
+		{Name: "PASSWORD", Message: "removed default", Breaking: true},
+		{Name: "PASSWORD", Message: "secret changed from true to false"},
+	}
+	reverted := describe(func(c *envcfg.Cfg) {
+		_ = c.String("PASSWORD")
+	})
+	if diffs := envcfg.Compare(snapshot(t, cur[:1]), reverted); !reflect.DeepEqual(want, diffs) {
+		t.Errorf("Compare() got\n%v\nwant\n%v", diffs, want)
+	}
+}
@@ -0,0 +1,98 @@
+package envcfg
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parser parses variables of a user-defined type; see Cfg.Custom.
+type Parser interface {
+	// TypeName is the name of the parsed type, as reported in Description.Type.
+	TypeName() string
+	// Parse parses the raw value of a variable -- or the default, if specified as a string.
+	Parse(s string) (interface{}, error)
+	// Describe returns the parser's parameters, as reported in Description.Params.
+	Describe() interface{}
+}
+
+// Formatter may be implemented by a Parser to format values back into the syntax accepted by Parse, e.g. when rendering
+// defaults in documentation. Parsers which don't implement it have their values formatted with fmt.Sprint.
+type Formatter interface {
+	Format(v interface{}) string
+}
+
+// Custom extracts and parses a variable of a user-defined type using the Parser and options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// The remainder of the string may specify any of the universal options -- e.g. "default" or "optional" -- or a comment,
+// exactly as for the built-in types. The returned value is whatever the Parser returned, or nil.
+func (c *Cfg) Custom(docOpts string, p Parser, opts ...UniOpt) interface{} {
+	s, err := newCustomSpec(docOpts, p, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return nil
+	}
+	return c.register(s)
+}
+
+// CustomDefault specifies a default value for a Custom variable. It should be of the same type returned by the Parser.
+func CustomDefault(def interface{}) UniOpt {
+	return defaultOpt(def)
+}
+
+func newCustomSpec(docOpts string, p Parser, opts []UniOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	s := &spec{
+		parser:   customParser{p},
+		typeName: p.TypeName(),
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		opt, err := parseUniOpt(strings.ToLower(f[0]), f[1])
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = s.parse(s.defaultValS); err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+// customParser adapts a Parser to the internal parser interface.
+type customParser struct {
+	Parser
+}
+
+func (p customParser) parse(s string) (interface{}, error) {
+	return p.Parse(s)
+}
+
+func (p customParser) format(v interface{}) string {
+	if f, ok := p.Parser.(Formatter); ok {
+		return f.Format(v)
+	}
+	return fmt.Sprint(v)
+}
+
+func (p customParser) describe() interface{} {
+	return p.Describe()
+}
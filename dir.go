@@ -0,0 +1,61 @@
+package envcfg
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DirFunc returns a function which looks up variables in the directories provided, in which each file's name is a
+// variable name and its content -- less a single trailing newline -- the value. This is the layout of Kubernetes
+// ConfigMaps and Secrets mounted as volumes. The directories are read once, when DirFunc is called.
+//
+// Files whose names begin with a dot are ignored, as are directories; symlinks are followed, so the files Kubernetes
+// links to its ..data directory are read while the directory itself is not. When a variable is in more than one
+// directory, the last wins.
+//
+// To fall back to the directory for variables not set in the process environment, use DirSource with Sources:
+//
+//	src, err := envcfg.DirSource("/etc/config")
+//	...
+//	cfg := envcfg.New(envcfg.Sources(envcfg.ProcessEnv(), src))
+func DirFunc(dirs ...string) (func(string) (string, bool), error) {
+	vars, err := readDirs(dirs)
+	if err != nil {
+		return nil, err
+	}
+	return func(s string) (string, bool) {
+		v, ok := vars[s]
+		return v, ok
+	}, nil
+}
+
+func readDirs(dirs []string) (map[string]string, error) {
+	vars := make(map[string]string)
+	for _, dir := range dirs {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if strings.HasPrefix(e.Name(), ".") {
+				continue
+			}
+			path := filepath.Join(dir, e.Name())
+			info, err := os.Stat(path) // follows symlinks
+			if err != nil {
+				return nil, err
+			}
+			if !info.Mode().IsRegular() {
+				continue
+			}
+			b, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			vars[e.Name()] = trimNewline(string(b))
+		}
+	}
+	return vars, nil
+}
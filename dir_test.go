@@ -0,0 +1,69 @@
+package envcfg_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jwilner/envcfg"
+)
+
+func TestDirSource(t *testing.T) {
+	// the layout of a mounted Kubernetes ConfigMap: each key is a symlink into ..data, itself a symlink to a timestamped
+	// directory which is swapped atomically on update
+	dir := t.TempDir()
+	data := filepath.Join(dir, "..2020_01_02_03_04_05.000000001")
+	if err := os.Mkdir(data, 0700); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{"LOG_LEVEL": "debug\n", "POOL_SIZE": "10", "HOST": "from-dir"} {
+		if err := ioutil.WriteFile(filepath.Join(data, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(filepath.Join("..data", name), filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink(filepath.Base(data), filepath.Join(dir, "..data")); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, ".hidden"), []byte("x"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "NESTED"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	src, err := envcfg.DirSource(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := envcfg.New(
+		envcfg.Panic(false),
+		envcfg.Sources(envcfg.MapSource("env", map[string]string{"HOST": "from-env"}), src),
+	)
+	got := []interface{}{
+		c.String("LOG_LEVEL"),
+		c.Int("POOL_SIZE"),
+		c.String("HOST"),
+		c.Has(".hidden"),
+		c.Has("..data"),
+		c.Has("NESTED"),
+	}
+	descriptions, err := c.Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []interface{}{"debug", int64(10), "from-env", false, false, false}; !reflect.DeepEqual(want, got) {
+		t.Errorf("DirSource() values = %v, want %v", got, want)
+	}
+	if want := "dir:" + dir; descriptions[0].Provenance != want {
+		t.Errorf("DirSource() provenance = %q, want %q", descriptions[0].Provenance, want)
+	}
+
+	if _, err := envcfg.DirFunc(filepath.Join(dir, "missing")); !os.IsNotExist(err) {
+		t.Errorf("DirFunc() of a missing directory err = %v, want not exist", err)
+	}
+}
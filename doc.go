@@ -11,12 +11,39 @@ For example, the following two invocations are functionally identical:
 
 	cfg.Int("MY_EXAMPLE_INT default=a base=16 | A really cool int")
 
-After parsing, errors can be checked with `Err`:
+Alternatively, a whole struct can be loaded at once, with each field's `env` tag used as the primary string for the
+method matching the field's type:
+
+	var conf struct {
+		MyExampleInt int64 `env:"MY_EXAMPLE_INT default=a base=16 | A really cool int"`
+	}
+	cfg.Load(&conf)
+
+Fields of other bool, string, integer and float types -- e.g. int or a named string type -- are extracted with the
+method for the 64-bit type of the same kind and converted.
+
+Variables are read from the process environment by default. The EnvFunc and Sources options allow reading from
+elsewhere -- e.g. dotenv files or directories of files such as mounted Kubernetes ConfigMaps -- with Sources consulting
+several lookups in order and recording which one provided each variable as its Description's Provenance.
+
+Reusable components can declare their variables against a child Cfg returned by Prefix, which prepends a prefix to each
+name -- e.g. so that one function declaring POOL_SIZE can configure both PAYMENTS_POOL_SIZE and ORDERS_POOL_SIZE. The
+child shares its parent's errors and descriptions.
+
+After parsing, errors must be checked with `Err`:
 
 	if err := cfg.Err(); err != nil {
 		log.Fatalf("failed loading config: %v", err)
 	}
 
+Cfg collects every error, so that `Err` reports every missing or malformed variable at once as an envcfg.Errors; the
+values of variables with errors are left as zero values. Constructed with envcfg.Panic(true), it instead panics on the
+first error. With envcfg.Strict, `Err` also reports variables set with a prefix which were never declared, e.g.
+misspelled overrides.
+
+Variables marked reloadable may change while the process runs: Cfg.Watch re-evaluates every variable on an interval or
+signal and notifies subscribers of the changes, noting any to other variables as requiring a restart.
+
 Describe of the config interface can also be printed (e.g. as JSON):
 
 	json.NewEncoder(os.Stdout).Encode(cfg.Describe())
@@ -0,0 +1,266 @@
+package envcfg
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// DotEnvFunc reads the dotenv files provided and returns a function suitable for use with EnvFunc.
+//
+// Each line of a file is either blank, a comment beginning with "#" or a KEY=VALUE assignment, optionally prefixed with
+// "export". Values may be unquoted, in which case they end at the line's end or an inline comment, single-quoted, in
+// which case they are taken literally, or double-quoted, in which case escapes (\n, \t, \", \\ and \$) are interpreted.
+// Quoted values may span multiple lines. References of the form ${VAR} or $VAR in unquoted and double-quoted values are
+// replaced with the value of VAR as previously assigned in the files or, failing that, as set in the process
+// environment.
+//
+// When a variable is assigned more than once, the last assignment -- including from later files -- wins. Errors
+// identify the file and line at which parsing failed.
+func DotEnvFunc(files ...string) (func(string) (string, bool), error) {
+	vars, err := readDotEnv(files)
+	if err != nil {
+		return nil, err
+	}
+	return func(s string) (string, bool) {
+		v, ok := vars[s]
+		return v, ok
+	}, nil
+}
+
+func readDotEnv(files []string) (map[string]string, error) {
+	vars := make(map[string]string)
+	for _, name := range files {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		p := dotEnvParser{src: string(b), line: 1, vars: vars}
+		if err := p.parse(); err != nil {
+			return nil, fmt.Errorf("%v:%d: %v", name, p.line, err)
+		}
+	}
+	return vars, nil
+}
+
+var dotEnvKeyRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+type dotEnvParser struct {
+	src  string
+	pos  int
+	line int
+	vars map[string]string
+}
+
+func (p *dotEnvParser) parse() error {
+	for {
+		p.skipFunc(func(c byte) bool {
+			return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+		})
+		if p.pos >= len(p.src) {
+			return nil
+		}
+		if p.src[p.pos] == '#' {
+			p.skipComment()
+			continue
+		}
+		if err := p.assignment(); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *dotEnvParser) assignment() error {
+	start := p.pos
+	p.skipFunc(func(c byte) bool {
+		return c != '=' && c != '\n'
+	})
+	if p.pos >= len(p.src) || p.src[p.pos] != '=' {
+		return errors.New("expected KEY=VALUE")
+	}
+
+	key := strings.TrimSpace(p.src[start:p.pos])
+	if strings.HasPrefix(key, "export ") || strings.HasPrefix(key, "export\t") {
+		key = strings.TrimSpace(key[len("export"):])
+	}
+	if !dotEnvKeyRegexp.MatchString(key) {
+		return fmt.Errorf("invalid variable name %q", key)
+	}
+
+	p.pos++ // consume '='
+	p.skipFunc(func(c byte) bool {
+		return c == ' ' || c == '\t'
+	})
+
+	val, err := p.value()
+	if err != nil {
+		return err
+	}
+	p.vars[key] = val
+	return nil
+}
+
+func (p *dotEnvParser) value() (string, error) {
+	if p.pos >= len(p.src) {
+		return "", nil
+	}
+
+	switch p.src[p.pos] {
+	case '\'':
+		end := strings.IndexByte(p.src[p.pos+1:], '\'')
+		if end < 0 {
+			return "", errors.New("unterminated single-quoted value")
+		}
+		val := p.src[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+		p.line += strings.Count(val, "\n")
+		return val, p.endOfLine()
+
+	case '"':
+		var (
+			b    strings.Builder
+			line = p.line
+		)
+		for p.pos++; p.pos < len(p.src); {
+			c := p.src[p.pos]
+			switch c {
+			case '"':
+				p.pos++
+				return b.String(), p.endOfLine()
+			case '\\':
+				if p.pos+1 < len(p.src) {
+					switch next := p.src[p.pos+1]; next {
+					case 'n':
+						b.WriteByte('\n')
+					case 'r':
+						b.WriteByte('\r')
+					case 't':
+						b.WriteByte('\t')
+					case '"', '\\', '$':
+						b.WriteByte(next)
+					default:
+						b.WriteByte(c)
+						b.WriteByte(next)
+					}
+					p.pos += 2
+					continue
+				}
+			case '$':
+				val, n, err := p.reference(p.src[p.pos:])
+				if err != nil {
+					return "", err
+				}
+				b.WriteString(val)
+				p.pos += n
+				continue
+			case '\n':
+				p.line++
+			}
+			b.WriteByte(c)
+			p.pos++
+		}
+		p.line = line
+		return "", errors.New("unterminated double-quoted value")
+
+	default:
+		start := p.pos
+		p.skipFunc(func(c byte) bool {
+			return c != '\n'
+		})
+		raw := p.src[start:p.pos]
+		for i := 1; i < len(raw); i++ {
+			if raw[i] == '#' && (raw[i-1] == ' ' || raw[i-1] == '\t') {
+				raw = raw[:i]
+				break
+			}
+		}
+		return p.expand(strings.TrimRight(raw, " \t\r"))
+	}
+}
+
+// expand replaces all variable references in s.
+func (p *dotEnvParser) expand(s string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if s[i] != '$' {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		val, n, err := p.reference(s[i:])
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(val)
+		i += n
+	}
+	return b.String(), nil
+}
+
+// reference resolves the variable reference at the beginning of s, returning its value and length. A "$" which does not
+// begin a reference is returned as is.
+func (p *dotEnvParser) reference(s string) (string, int, error) {
+	if strings.HasPrefix(s, "${") {
+		end := strings.IndexByte(s, '}')
+		if end < 0 {
+			return "", 0, errors.New("unterminated variable reference")
+		}
+		return p.lookup(s[2:end]), end + 1, nil
+	}
+
+	end := 1
+	for end < len(s) && (s[end] == '_' || isAlpha(s[end]) || end > 1 && isDigit(s[end])) {
+		end++
+	}
+	if end == 1 {
+		return "$", 1, nil
+	}
+	return p.lookup(s[1:end]), end, nil
+}
+
+func (p *dotEnvParser) lookup(name string) string {
+	if v, ok := p.vars[name]; ok {
+		return v
+	}
+	return os.Getenv(name)
+}
+
+// endOfLine consumes the remainder of the line following a quoted value, which may only contain a comment.
+func (p *dotEnvParser) endOfLine() error {
+	p.skipFunc(func(c byte) bool {
+		return c == ' ' || c == '\t' || c == '\r'
+	})
+	if p.pos < len(p.src) && p.src[p.pos] == '#' {
+		p.skipComment()
+	}
+	if p.pos < len(p.src) && p.src[p.pos] != '\n' {
+		return errors.New("unexpected characters after quoted value")
+	}
+	return nil
+}
+
+func (p *dotEnvParser) skipComment() {
+	p.skipFunc(func(c byte) bool {
+		return c != '\n'
+	})
+}
+
+// skipFunc advances past all bytes satisfying f, counting newlines.
+func (p *dotEnvParser) skipFunc(f func(c byte) bool) {
+	for ; p.pos < len(p.src) && f(p.src[p.pos]); p.pos++ {
+		if p.src[p.pos] == '\n' {
+			p.line++
+		}
+	}
+}
+
+func isAlpha(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
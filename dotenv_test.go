@@ -0,0 +1,108 @@
+package envcfg
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func Test_dotEnvParser(t *testing.T) {
+	if err := os.Setenv("ENVCFG_DOTENV_TEST", "from-env"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("ENVCFG_DOTENV_TEST")
+
+	tests := []struct {
+		name, src, wantErr string
+		want               map[string]string
+	}{
+		{name: "empty", src: "", want: map[string]string{}},
+		{name: "comments and blanks", src: "# a comment\n\n   # another\n", want: map[string]string{}},
+		{name: "simple", src: "A=1\nB = 2 \n", want: map[string]string{"A": "1", "B": "2"}},
+		{name: "empty value", src: "A=\nB=", want: map[string]string{"A": "", "B": ""}},
+		{name: "export", src: "export A=1\nexport\tB=2", want: map[string]string{"A": "1", "B": "2"}},
+		{name: "crlf", src: "A=1\r\nB=2\r\n", want: map[string]string{"A": "1", "B": "2"}},
+		{name: "inline comment", src: "A=1 # one\nB=a#b", want: map[string]string{"A": "1", "B": "a#b"}},
+		{
+			name: "single quoted",
+			src:  `A='$B \n # "x"' # comment`,
+			want: map[string]string{"A": `$B \n # "x"`},
+		},
+		{
+			name: "double quoted",
+			src:  `A="a\tb \"c\" \\ \$B # d"`,
+			want: map[string]string{"A": "a\tb \"c\" \\ $B # d"},
+		},
+		{
+			name: "multiline",
+			src:  "A=\"line one\nline two\"\nB='x\ny'\nC=3",
+			want: map[string]string{"A": "line one\nline two", "B": "x\ny", "C": "3"},
+		},
+		{
+			name: "references",
+			src:  "A=1\nB=${A}2\nC=\"$B-${ENVCFG_DOTENV_TEST}\"\nD=$ $1 ${MISSING}",
+			want: map[string]string{"A": "1", "B": "12", "C": "12-from-env", "D": "$ $1 "},
+		},
+		{name: "reassignment", src: "A=1\nA=2", want: map[string]string{"A": "2"}},
+		{name: "missing equals", src: "A=1\n\nB\n", wantErr: "3: expected KEY=VALUE"},
+		{name: "invalid name", src: "A B=1", wantErr: `1: invalid variable name "A B"`},
+		{name: "unterminated single", src: "A=1\nB='abc\n\n", wantErr: "2: unterminated single-quoted value"},
+		{name: "unterminated double", src: "A=1\nB=\"abc\n\n", wantErr: "2: unterminated double-quoted value"},
+		{name: "trailing garbage", src: "A=\"abc\" def", wantErr: "1: unexpected characters after quoted value"},
+		{name: "unterminated reference", src: "A=${B", wantErr: "1: unterminated variable reference"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := dotEnvParser{src: tt.src, line: 1, vars: make(map[string]string)}
+
+			var errS string
+			if err := p.parse(); err != nil {
+				errS = fmt.Sprintf("%d: %v", p.line, err)
+			}
+			if errS != tt.wantErr {
+				t.Fatalf("parse() error = %q, wantErr %q", errS, tt.wantErr)
+			}
+			if tt.wantErr == "" && !reflect.DeepEqual(p.vars, tt.want) {
+				t.Errorf("parse() got = %q, want %q", p.vars, tt.want)
+			}
+		})
+	}
+}
+
+func TestDotEnvFunc(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	var (
+		base     = write(".env", "A=1\nB=2\n")
+		override = write(".env.local", "B=${A}3\n")
+		broken   = write(".env.broken", "A=1\n\nB='\n")
+	)
+
+	envFunc, err := DotEnvFunc(base, override)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, want := range map[string]string{"A": "1", "B": "13"} {
+		if v, ok := envFunc(k); !ok || v != want {
+			t.Errorf("envFunc(%q) = %q, %v; want %q", k, v, ok, want)
+		}
+	}
+	if _, ok := envFunc("C"); ok {
+		t.Errorf("envFunc(%q) unexpectedly found", "C")
+	}
+
+	_, err = DotEnvFunc(base, broken)
+	if want := broken + ":3: unterminated single-quoted value"; err == nil || err.Error() != want {
+		t.Errorf("DotEnvFunc() error = %v, want %v", err, want)
+	}
+}
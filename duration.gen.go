@@ -3,7 +3,6 @@ package envcfg
 // Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
 
 import (
-	"errors"
 	"strings"
 	"time"
 )
@@ -16,18 +15,19 @@ import (
 //
 // Available options:
 // 		- "default" or DurationDefault
+// 		- "file_ok" or FileOK
+// 		- "max" or DurationMax
+// 		- "min" or DurationMin
 // 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 func (c *Cfg) Duration(docOpts string, opts ...DurationOpt) (v time.Duration) {
 	s, err := newDurationSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).(time.Duration)
+	v, _ = c.register(s).(time.Duration)
 	return
 }
 
@@ -42,6 +42,20 @@ func DurationDefault(def time.Duration) DurationOpt {
 	return defaultOpt(def)
 }
 
+// DurationMax specifies the maximum permitted value for a Duration variable.
+func DurationMax(max time.Duration) DurationOpt {
+	return durationOptFunc(func(p *durationParser) {
+		p.max = &max
+	})
+}
+
+// DurationMin specifies the minimum permitted value for a Duration variable.
+func DurationMin(min time.Duration) DurationOpt {
+	return durationOptFunc(func(p *durationParser) {
+		p.min = &min
+	})
+}
+
 type durationOptFunc func(p *durationParser)
 
 func (f durationOptFunc) modifyDurationParser(p *durationParser) {
@@ -55,7 +69,7 @@ var _ DurationOpt = new(durationOptFunc)
 func newDurationSpec(docOpts string, opts []DurationOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(durationParser)
@@ -66,29 +80,27 @@ func newDurationSpec(docOpts string, opts []DurationOpt) (*spec, error) {
 		comment:  parsed.description,
 	}
 
+	values := make(map[string]string)
 	for _, f := range parsed.fields {
 		var (
 			opt DurationOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
-		case "optional":
-			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
-			}
-			opt = Optional
+		switch key := strings.ToLower(f[0]); key {
+		case "max":
+			values[key] = f[1]
+			continue
+		case "min":
+			values[key] = f[1]
+			continue
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyDurationParser(p)
@@ -99,9 +111,35 @@ func newDurationSpec(docOpts string, opts []DurationOpt) (*spec, error) {
 		opt.modifyDurationParser(p)
 	}
 
+	// value params are parsed once the options they depend on are known, but typed options take precedence
+	if val, ok := values["max"]; ok {
+		v, err := p.parse(val)
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "max", Err: err}
+		}
+		if p.max == nil {
+			max := v.(time.Duration)
+			p.max = &max
+		}
+	}
+
+	if val, ok := values["min"]; ok {
+		v, err := p.parse(val)
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "min", Err: err}
+		}
+		if p.min == nil {
+			min := v.(time.Duration)
+			p.min = &min
+		}
+	}
+
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -109,14 +147,50 @@ func newDurationSpec(docOpts string, opts []DurationOpt) (*spec, error) {
 }
 
 type durationParser struct {
+	max *time.Duration
+	min *time.Duration
 }
 
 func (p *durationParser) parse(s string) (interface{}, error) {
-	return time.ParseDuration(
+	v, err := time.ParseDuration(
 		s,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validate(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *durationParser) validate(v time.Duration) error {
+	if p.min != nil && v < *p.min {
+		return valueErrorf("must be at least %v", p.format(*p.min))
+	}
+	if p.max != nil && v > *p.max {
+		return valueErrorf("must be at most %v", p.format(*p.max))
+	}
+	return nil
+}
+
+func (p *durationParser) format(val interface{}) string {
+	v, _ := val.(time.Duration)
+	return v.String()
 }
 
 func (p *durationParser) describe() interface{} {
-	return struct{}{}
+	d := durationParserDescription{}
+	if p.max != nil {
+		d.Max = p.format(*p.max)
+	}
+	if p.min != nil {
+		d.Min = p.format(*p.min)
+	}
+	return d
+}
+
+type durationParserDescription struct {
+	Max string `json:"max,omitempty"`
+	Min string `json:"min,omitempty"`
 }
@@ -0,0 +1,245 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationMap extracts and parses a map[string]time.Duration variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe DurationMapOpts.
+//
+// Available options:
+// 		- "comma" or DurationMapComma
+// 		- "default" or DurationMapDefault
+// 		- "file_ok" or FileOK
+// 		- "key_value_sep" or DurationMapKeyValueSep
+// 		- "max_len" or DurationMapMaxLen
+// 		- "min_len" or DurationMapMinLen
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) DurationMap(docOpts string, opts ...DurationMapOpt) (v map[string]time.Duration) {
+	s, err := newDurationMapSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).(map[string]time.Duration)
+	return
+}
+
+// DurationMapOpt modifies DurationMap variable configuration.
+type DurationMapOpt interface {
+	modify(s *spec)
+	modifyDurationMapParser(p *durationMapParser)
+}
+
+// DurationMapComma specifies the comma to use for a DurationMap variable.
+func DurationMapComma(comma rune) DurationMapOpt {
+	return durationMapOptFunc(func(p *durationMapParser) {
+		p.comma = comma
+	})
+}
+
+// DurationMapDefault specifies a default value for a DurationMap variable.
+func DurationMapDefault(def map[string]time.Duration) DurationMapOpt {
+	return defaultOpt(def)
+}
+
+// DurationMapKeyValueSep specifies the separator between keys and values for a DurationMap variable.
+func DurationMapKeyValueSep(keyValueSep rune) DurationMapOpt {
+	return durationMapOptFunc(func(p *durationMapParser) {
+		p.keyValueSep = keyValueSep
+	})
+}
+
+// DurationMapMaxLen specifies the maximum permitted length for a DurationMap variable.
+func DurationMapMaxLen(maxLen int) DurationMapOpt {
+	return durationMapOptFunc(func(p *durationMapParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// DurationMapMinLen specifies the minimum permitted length for a DurationMap variable.
+func DurationMapMinLen(minLen int) DurationMapOpt {
+	return durationMapOptFunc(func(p *durationMapParser) {
+		p.minLen = minLen
+	})
+}
+
+type durationMapOptFunc func(p *durationMapParser)
+
+func (f durationMapOptFunc) modifyDurationMapParser(p *durationMapParser) {
+	f(p)
+}
+
+func (durationMapOptFunc) modify(*spec) {}
+
+var _ DurationMapOpt = new(durationMapOptFunc)
+
+func newDurationMapSpec(docOpts string, opts []DurationMapOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(durationMapParser)
+	s := &spec{
+		parser:   p,
+		typeName: "map[string]time.Duration",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt DurationMapOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "comma":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = DurationMapComma(value[0])
+		case "key_value_sep":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = DurationMapKeyValueSep(value[0])
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = DurationMapMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = DurationMapMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyDurationMapParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyDurationMapParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type durationMapParser struct {
+	comma       rune
+	keyValueSep rune
+	maxLen      int
+	minLen      int
+}
+
+func (p *durationMapParser) parse(s string) (interface{}, error) {
+	pairs, err := parsePairs(s, p.comma, p.keyValueSep)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make(map[string]time.Duration, len(pairs))
+	for _, kv := range pairs {
+		k, v := kv[0], kv[1]
+		el, err := time.ParseDuration(
+			v,
+		)
+		if err != nil {
+			return nil, keyError(k, err)
+		}
+		vals[k] = el
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+}
+
+func (p *durationMapParser) validate(v map[string]time.Duration) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *durationMapParser) format(val interface{}) string {
+	vals, _ := val.(map[string]time.Duration)
+	ses := make(map[string]string, len(vals))
+	for k, v := range vals {
+		ses[k] = v.String()
+	}
+	return formatPairs(ses, p.comma, p.keyValueSep)
+}
+
+func (p *durationMapParser) describe() interface{} {
+	return durationMapParserDescription{
+		Comma:       p.comma,
+		KeyValueSep: p.keyValueSep,
+		MaxLen:      p.maxLen,
+		MinLen:      p.minLen,
+	}
+}
+
+type durationMapParserDescription struct {
+	Comma       rune `json:"comma,omitempty"`
+	KeyValueSep rune `json:"key_value_sep,omitempty"`
+	MaxLen      int  `json:"max_len,omitempty"`
+	MinLen      int  `json:"min_len,omitempty"`
+}
+
+func (d durationMapParserDescription) MarshalJSON() ([]byte, error) {
+	var comma string
+	if d.Comma != 0 {
+		comma = string(d.Comma)
+	}
+	var keyValueSep string
+	if d.KeyValueSep != 0 {
+		keyValueSep = string(d.KeyValueSep)
+	}
+	return json.Marshal(struct {
+		Comma       string `json:"comma,omitempty"`
+		KeyValueSep string `json:"key_value_sep,omitempty"`
+		MaxLen      int    `json:"max_len,omitempty"`
+		MinLen      int    `json:"min_len,omitempty"`
+	}{
+		Comma:       comma,
+		KeyValueSep: keyValueSep,
+		MaxLen:      d.MaxLen,
+		MinLen:      d.MinLen,
+	})
+}
@@ -0,0 +1,221 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DurationSlice extracts and parses a []time.Duration variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe DurationSliceOpts.
+//
+// Available options:
+// 		- "comma" or DurationSliceComma
+// 		- "default" or DurationSliceDefault
+// 		- "file_ok" or FileOK
+// 		- "max_len" or DurationSliceMaxLen
+// 		- "min_len" or DurationSliceMinLen
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) DurationSlice(docOpts string, opts ...DurationSliceOpt) (v []time.Duration) {
+	s, err := newDurationSliceSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).([]time.Duration)
+	return
+}
+
+// DurationSliceOpt modifies DurationSlice variable configuration.
+type DurationSliceOpt interface {
+	modify(s *spec)
+	modifyDurationSliceParser(p *durationSliceParser)
+}
+
+// DurationSliceComma specifies the comma to use for a DurationSlice variable.
+func DurationSliceComma(comma rune) DurationSliceOpt {
+	return durationSliceOptFunc(func(p *durationSliceParser) {
+		p.comma = comma
+	})
+}
+
+// DurationSliceDefault specifies a default value for a DurationSlice variable.
+func DurationSliceDefault(def []time.Duration) DurationSliceOpt {
+	return defaultOpt(def)
+}
+
+// DurationSliceMaxLen specifies the maximum permitted length for a DurationSlice variable.
+func DurationSliceMaxLen(maxLen int) DurationSliceOpt {
+	return durationSliceOptFunc(func(p *durationSliceParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// DurationSliceMinLen specifies the minimum permitted length for a DurationSlice variable.
+func DurationSliceMinLen(minLen int) DurationSliceOpt {
+	return durationSliceOptFunc(func(p *durationSliceParser) {
+		p.minLen = minLen
+	})
+}
+
+type durationSliceOptFunc func(p *durationSliceParser)
+
+func (f durationSliceOptFunc) modifyDurationSliceParser(p *durationSliceParser) {
+	f(p)
+}
+
+func (durationSliceOptFunc) modify(*spec) {}
+
+var _ DurationSliceOpt = new(durationSliceOptFunc)
+
+func newDurationSliceSpec(docOpts string, opts []DurationSliceOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(durationSliceParser)
+	s := &spec{
+		parser:   p,
+		typeName: "[]time.Duration",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt DurationSliceOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "comma":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = DurationSliceComma(value[0])
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = DurationSliceMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = DurationSliceMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyDurationSliceParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyDurationSliceParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type durationSliceParser struct {
+	comma  rune
+	maxLen int
+	minLen int
+}
+
+func (p *durationSliceParser) parse(s string) (interface{}, error) {
+	ses, err := parseSlice(s, p.comma)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make([]time.Duration, len(ses))
+	for i, v := range ses {
+		el, err := time.ParseDuration(
+			v,
+		)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		vals[i] = el
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+
+}
+
+func (p *durationSliceParser) validate(v []time.Duration) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *durationSliceParser) format(val interface{}) string {
+	vals, _ := val.([]time.Duration)
+	ses := make([]string, len(vals))
+	for i, v := range vals {
+		ses[i] = v.String()
+	}
+	return formatSlice(ses, p.comma)
+}
+
+func (p *durationSliceParser) describe() interface{} {
+	return durationSliceParserDescription{
+		Comma:  p.comma,
+		MaxLen: p.maxLen,
+		MinLen: p.minLen,
+	}
+}
+
+type durationSliceParserDescription struct {
+	Comma  rune `json:"comma,omitempty"`
+	MaxLen int  `json:"max_len,omitempty"`
+	MinLen int  `json:"min_len,omitempty"`
+}
+
+func (d durationSliceParserDescription) MarshalJSON() ([]byte, error) {
+	var comma string
+	if d.Comma != 0 {
+		comma = string(d.Comma)
+	}
+	return json.Marshal(struct {
+		Comma  string `json:"comma,omitempty"`
+		MaxLen int    `json:"max_len,omitempty"`
+		MinLen int    `json:"min_len,omitempty"`
+	}{
+		Comma:  comma,
+		MaxLen: d.MaxLen,
+		MinLen: d.MinLen,
+	})
+}
@@ -0,0 +1,173 @@
+// Package envcfgtest provides helpers for testing code which uses envcfg: a map-backed EnvFunc, golden file assertions
+// of the variables a configuration function declares, and a runner for tables of environments.
+package envcfgtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/jwilner/envcfg"
+)
+
+var update = flag.Bool("envcfgtest.update", false, "update envcfgtest golden files")
+
+// EnvFunc returns a function looking up variables in the map, for use with envcfg.EnvFunc.
+func EnvFunc(env map[string]string) func(string) (string, bool) {
+	return func(s string) (string, bool) {
+		v, ok := env[s]
+		return v, ok
+	}
+}
+
+// New returns a Cfg reading from the map, as the source named "env", which collects errors rather than panicking. The
+// map supports listing, so New may be used with envcfg.Strict.
+func New(env map[string]string, opts ...envcfg.Option) *envcfg.Cfg {
+	src := envcfg.MapSource("env", env)
+	return envcfg.New(append([]envcfg.Option{envcfg.Panic(false), envcfg.Sources(src)}, opts...)...)
+}
+
+// Describe runs the configuration function against an empty environment and returns the descriptions of the variables
+// it declared, without their provenances, which depend on the environment.
+func Describe(configure func(c *envcfg.Cfg)) []envcfg.Description {
+	c := New(nil)
+	configure(c)
+	descs := append([]envcfg.Description(nil), c.Describe()...)
+	for i := range descs {
+		descs[i].Provenance = ""
+	}
+	return descs
+}
+
+// AssertGolden asserts that the variables declared by the configuration function, as returned by Describe, match those
+// in the JSON golden file, failing the test with both if not. When the test is run with -envcfgtest.update, the golden
+// file is written instead.
+func AssertGolden(t testing.TB, configure func(c *envcfg.Cfg), golden string) {
+	t.Helper()
+
+	got, err := json.MarshalIndent(Describe(configure), "", "  ")
+	if err != nil {
+		t.Fatalf("marshalling descriptions: %v", err)
+	}
+	got = append(got, '\n')
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(golden, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("reading golden file (run with -envcfgtest.update to create it): %v", err)
+	}
+	if !jsonEqual(want, got) {
+		t.Errorf("descriptions do not match %v (run with -envcfgtest.update to update it)\ngot:\n%s\nwant:\n%s",
+			golden, got, bytes.TrimSpace(want))
+	}
+}
+
+// AssertCompatible asserts that the variables declared by the configuration function are backwards compatible with
+// those in the JSON golden file -- e.g. that no default has changed -- failing the test with the breaking differences,
+// as classified by envcfg.Compare, if not; non-breaking differences are logged. When the test is run with
+// -envcfgtest.update, the golden file is written instead.
+func AssertCompatible(t testing.TB, configure func(c *envcfg.Cfg), golden string) {
+	t.Helper()
+	if *update {
+		AssertGolden(t, configure, golden)
+		return
+	}
+
+	b, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("reading golden file (run with -envcfgtest.update to create it): %v", err)
+	}
+	var prev []envcfg.Description
+	if err := json.Unmarshal(b, &prev); err != nil {
+		t.Fatalf("unmarshalling %v: %v", golden, err)
+	}
+
+	for _, d := range envcfg.Compare(prev, Describe(configure)) {
+		if d.Breaking {
+			t.Errorf("%v: %v", golden, d)
+		} else {
+			t.Logf("%v: %v", golden, d)
+		}
+	}
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// Errors runs the configuration function against each named environment and returns the errors of those for which it
+// failed, keyed by name.
+func Errors(configure func(c *envcfg.Cfg), envs map[string]map[string]string) map[string]error {
+	errs := make(map[string]error)
+	for name, env := range envs {
+		c := New(env)
+		configure(c)
+		if err := c.Err(); err != nil {
+			errs[name] = err
+		}
+	}
+	return errs
+}
+
+// Case is an environment against which to run a configuration function.
+type Case struct {
+	Name string
+	Env  map[string]string
+	// WantErr is the expected error message, or empty if the configuration should be valid.
+	WantErr string
+}
+
+// Run runs the configuration function against each case's environment in a subtest, failing it if the error doesn't
+// match the case's WantErr.
+func Run(t *testing.T, configure func(c *envcfg.Cfg), cases []Case) {
+	t.Helper()
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Helper()
+			c := New(tc.Env)
+			configure(c)
+			var msg string
+			if err := c.Err(); err != nil {
+				msg = err.Error()
+			}
+			if msg != tc.WantErr {
+				t.Errorf("configure() err = %q, want %q", msg, tc.WantErr)
+			}
+		})
+	}
+}
+
+// AssertDeclares asserts that the configuration function declares exactly the named variables, in any order.
+func AssertDeclares(t testing.TB, configure func(c *envcfg.Cfg), names ...string) {
+	t.Helper()
+	var got []string
+	for _, d := range Describe(configure) {
+		got = append(got, d.Name)
+	}
+	sort.Strings(got)
+	want := append([]string(nil), names...)
+	sort.Strings(want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("configure() declares %q, want %q", got, want)
+	}
+}
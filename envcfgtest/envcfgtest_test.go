@@ -0,0 +1,134 @@
+package envcfgtest_test
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jwilner/envcfg"
+	"github.com/jwilner/envcfg/envcfgtest"
+)
+
+func configure(c *envcfg.Cfg) {
+	_ = c.Int("PORT default=8080 | The port to listen on")
+	_ = c.String("LOG_LEVEL oneof=debug,info optional")
+	_ = c.URL("DATABASE_URL schemes=postgres")
+}
+
+// recorder records failures rather than failing the test.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertGolden(t *testing.T) {
+	envcfgtest.AssertGolden(t, configure, filepath.Join("testdata", "configure.json"))
+	if flag.Lookup("envcfgtest.update").Value.String() == "true" {
+		return // the changed configuration would be written too
+	}
+
+	changed := func(c *envcfg.Cfg) {
+		configure(c)
+		_ = c.Bool("DEBUG")
+	}
+	r := &recorder{TB: t}
+	envcfgtest.AssertGolden(r, changed, filepath.Join("testdata", "configure.json"))
+	if len(r.errors) != 1 || !strings.Contains(r.errors[0], `"name": "DEBUG"`) {
+		t.Errorf("AssertGolden() of a changed configuration reported %q", r.errors)
+	}
+}
+
+func TestAssertCompatible(t *testing.T) {
+	envcfgtest.AssertCompatible(t, configure, filepath.Join("testdata", "configure.json"))
+	if flag.Lookup("envcfgtest.update").Value.String() == "true" {
+		return
+	}
+
+	changed := func(c *envcfg.Cfg) {
+		_ = c.Int("PORT default=8081 | The port to serve on")
+		_ = c.String("LOG_LEVEL oneof=debug,info optional")
+		_ = c.URL("DATABASE_URL schemes=postgres")
+		_ = c.Bool("DEBUG optional")
+	}
+	r := &recorder{TB: t}
+	envcfgtest.AssertCompatible(r, changed, filepath.Join("testdata", "configure.json"))
+	want := []string{filepath.Join("testdata", "configure.json") + ": PORT: default changed from 8080 to 8081 (breaking)"}
+	if !reflect.DeepEqual(want, r.errors) {
+		t.Errorf("AssertCompatible() reported %q, want %q", r.errors, want)
+	}
+}
+
+func (r *recorder) Logf(string, ...interface{}) {}
+
+func TestAssertDeclares(t *testing.T) {
+	envcfgtest.AssertDeclares(t, configure, "DATABASE_URL", "LOG_LEVEL", "PORT")
+
+	r := &recorder{TB: t}
+	envcfgtest.AssertDeclares(r, configure, "PORT")
+	want := []string{`configure() declares ["DATABASE_URL" "LOG_LEVEL" "PORT"], want ["PORT"]`}
+	if !reflect.DeepEqual(want, r.errors) {
+		t.Errorf("AssertDeclares() reported %q, want %q", r.errors, want)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	for _, d := range envcfgtest.Describe(configure) {
+		if d.Provenance != "" {
+			t.Errorf("%v: Describe() kept provenance %q", d.Name, d.Provenance)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	errs := envcfgtest.Errors(configure, map[string]map[string]string{
+		"valid":   {"DATABASE_URL": "postgres://db/app"},
+		"missing": {},
+		"invalid": {"DATABASE_URL": "postgres://db/app", "PORT": "http"},
+	})
+	got := make(map[string]string, len(errs))
+	for name, err := range errs {
+		got[name] = err.Error()
+	}
+	want := map[string]string{
+		"missing": "DATABASE_URL: variable is required",
+		"invalid": `PORT: invalid value "http": invalid syntax`,
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Errors() = %q, want %q", got, want)
+	}
+}
+
+func TestRun(t *testing.T) {
+	envcfgtest.Run(t, configure, []envcfgtest.Case{
+		{Name: "valid", Env: map[string]string{"DATABASE_URL": "postgres://db/app", "LOG_LEVEL": "debug"}},
+		{
+			Name:    "bad scheme",
+			Env:     map[string]string{"DATABASE_URL": "mysql://db/app"},
+			WantErr: `DATABASE_URL: invalid value "mysql://db/app": scheme must be one of postgres`,
+		},
+	})
+}
+
+func TestEnvFunc(t *testing.T) {
+	c := envcfg.New(envcfg.EnvFunc(envcfgtest.EnvFunc(map[string]string{"A": "1"})))
+	if got := c.Int("A"); got != 1 {
+		t.Errorf("EnvFunc() value = %v, want 1", got)
+	}
+}
+
+func TestNew_strict(t *testing.T) {
+	c := envcfgtest.New(map[string]string{"DATABASE_URL": "postgres://db/app", "PORTT": "80"}, envcfg.Strict(""))
+	configure(c)
+	if err := c.Err(); err == nil || err.Error() != "PORTT: undeclared variable set in env" {
+		t.Errorf("New() with Strict err = %v, want PORTT reported", err)
+	}
+}
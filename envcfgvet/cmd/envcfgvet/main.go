@@ -0,0 +1,12 @@
+// Command envcfgvet checks envcfg docOpts strings; run it with go vet -vettool=$(which envcfgvet).
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/jwilner/envcfg/envcfgvet"
+)
+
+func main() {
+	singlechecker.Main(envcfgvet.Analyzer)
+}
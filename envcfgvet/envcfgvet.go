@@ -0,0 +1,122 @@
+// Package envcfgvet provides an analyzer which checks constant docOpts strings passed to envcfg.Cfg methods -- e.g.
+// Cfg.Int or Cfg.TimeSlice -- at build time, reporting unknown options, invalid option values and defaults, and
+// variables declared more than once, all of which envcfg would otherwise only report at runtime. When typed options
+// are passed too, only the option keys are checked, since the validity of the values may depend on the typed options.
+//
+// It may be run with go vet using the envcfgvet command:
+//
+//	go install github.com/jwilner/envcfg/envcfgvet/cmd/envcfgvet@latest
+//	go vet -vettool=$(which envcfgvet) ./...
+package envcfgvet
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/jwilner/envcfg"
+)
+
+const envcfgPath = "github.com/jwilner/envcfg"
+
+// Analyzer checks the docOpts of calls to envcfg.Cfg methods.
+var Analyzer = &analysis.Analyzer{
+	Name: "envcfg",
+	Doc: "check envcfg docOpts strings\n\n" +
+		"Reports constant docOpts strings passed to envcfg.Cfg methods which the methods would reject -- e.g. " +
+		"with unknown options or defaults which don't parse -- and variables declared twice on the same Cfg in " +
+		"the same function.",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// declaration identifies a variable declared against a Cfg expression within a function.
+type declaration struct {
+	fn   ast.Node
+	recv string
+	name string
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	checkable := make(map[string]bool)
+	for _, m := range envcfg.DocOptsMethods() {
+		checkable[m] = true
+	}
+
+	declared := make(map[declaration]token.Pos)
+	in := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	in.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		call := n.(*ast.CallExpr)
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		method := cfgMethod(pass.TypesInfo, sel)
+		if !checkable[method] {
+			return true
+		}
+		tv := pass.TypesInfo.Types[call.Args[0]]
+		if tv.Value == nil || tv.Value.Kind() != constant.String {
+			return true // only constant docOpts can be checked
+		}
+
+		check := envcfg.CheckDocOpts
+		if len(call.Args) > 1 {
+			check = envcfg.CheckDocOptsKeys // the default and values may depend on the typed options
+		}
+		name, err := check(method, constant.StringVal(tv.Value))
+		if err != nil {
+			pass.Reportf(call.Args[0].Pos(), "%v", err)
+			return true
+		}
+
+		d := declaration{fn: enclosingFunc(stack), recv: types.ExprString(sel.X), name: name}
+		if pos, ok := declared[d]; ok {
+			pass.Reportf(call.Args[0].Pos(), "%v already declared at %v", name, pass.Fset.Position(pos))
+		} else {
+			declared[d] = call.Args[0].Pos()
+		}
+		return true
+	})
+	return nil, nil
+}
+
+// cfgMethod returns the name of the method if the selector is a method of envcfg.Cfg, or empty otherwise.
+func cfgMethod(info *types.Info, sel *ast.SelectorExpr) string {
+	selection, ok := info.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal {
+		return ""
+	}
+	recv := selection.Obj().(*types.Func).Type().(*types.Signature).Recv()
+	if recv == nil {
+		return ""
+	}
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != envcfgPath || named.Obj().Name() != "Cfg" {
+		return ""
+	}
+	return selection.Obj().Name()
+}
+
+// enclosingFunc returns the innermost function declaration or literal in the stack, or nil at package level.
+func enclosingFunc(stack []ast.Node) ast.Node {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch stack[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return stack[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package envcfgvet_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/jwilner/envcfg/envcfgvet"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), envcfgvet.Analyzer, "a")
+}
@@ -0,0 +1,13 @@
+module github.com/jwilner/envcfg/envcfgvet
+
+go 1.18
+
+require (
+	github.com/jwilner/envcfg v0.1.0
+	golang.org/x/tools v0.7.0
+)
+
+require (
+	golang.org/x/mod v0.9.0 // indirect
+	golang.org/x/sys v0.6.0 // indirect
+)
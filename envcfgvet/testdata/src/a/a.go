@@ -0,0 +1,34 @@
+package a
+
+import "github.com/jwilner/envcfg"
+
+const port = "PORT default=80"
+
+func configure(c *envcfg.Cfg, dynamic string) {
+	_ = c.Int(port)
+	_ = c.Int("PORT default=80")               // want `PORT already declared at .*a.go:8:12`
+	_ = c.Int("TIMEOUT default=abc")           // want `TIMEOUT: invalid option "default": .*invalid syntax`
+	_ = c.Duration("TIMEOUT optional verbose") // want `TIMEOUT: invalid option "verbose": unknown option`
+	_ = c.String(dynamic)
+	_ = c.Has("PORT")
+	_ = c.Prefix("DB_").Int("PORT")
+}
+
+func typed(c *envcfg.Cfg, intBase envcfg.IntOpt, uintBase envcfg.UintOpt) {
+	// the default and bounds may parse with the typed options -- e.g. envcfg.IntBase(16) -- so aren't checked
+	_ = c.Int("HEX default=ff", intBase)
+	_ = c.Uint("MASK min=f max=ff", uintBase)
+	_ = c.Int("TYPO bass=16", intBase) // want `TYPO: invalid option "bass": unknown option`
+}
+
+func other(c *envcfg.Cfg) {
+	_ = c.Int("PORT")
+}
+
+type notCfg struct{}
+
+func (notCfg) Int(string) int64 { return 0 }
+
+func unrelated() {
+	_ = notCfg{}.Int("PORT default=abc")
+}
@@ -0,0 +1,30 @@
+// Package envcfg is a stub of the methods checked by the analyzer.
+package envcfg
+
+type Cfg struct{}
+
+func New() *Cfg { return &Cfg{} }
+
+func (c *Cfg) Prefix(prefix string) *Cfg { return c }
+
+type IntOpt interface{}
+
+func IntBase(base int) IntOpt { return nil }
+
+func (c *Cfg) Int(docOpts string, opts ...IntOpt) int64 { return 0 }
+
+type UintOpt interface{}
+
+func UintBase(base int) UintOpt { return nil }
+
+func (c *Cfg) Uint(docOpts string, opts ...UintOpt) uint64 { return 0 }
+
+type StringOpt interface{}
+
+func (c *Cfg) String(docOpts string, opts ...StringOpt) string { return "" }
+
+type DurationOpt interface{}
+
+func (c *Cfg) Duration(docOpts string, opts ...DurationOpt) int64 { return 0 }
+
+func (c *Cfg) Has(name string) bool { return false }
@@ -0,0 +1,208 @@
+package envcfg
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownOption is wrapped by a DocOptsError when a docOpts string specifies an option the type doesn't support.
+var ErrUnknownOption = errors.New("unknown option")
+
+// ErrNotListable is reported by Err when Strict is specified but none of the sources support List -- e.g. because they
+// were all specified with EnvFunc -- so undeclared variables can't be found.
+var ErrNotListable = errors.New("strict: no source can list its variables")
+
+// MissingError indicates that a required variable was not set.
+type MissingError struct {
+	Name string
+}
+
+func (e *MissingError) Error() string {
+	return fmt.Sprintf("%v: variable is required", e.Name)
+}
+
+// UndeclaredError indicates that a variable with a Strict prefix was set but never declared -- e.g. a misspelling.
+type UndeclaredError struct {
+	Name string
+	// Source is the name of the Source in which the variable was set.
+	Source string
+}
+
+func (e *UndeclaredError) Error() string {
+	return fmt.Sprintf("%v: undeclared variable set in %v", e.Name, e.Source)
+}
+
+// FileError indicates that the file named by a FileOK variable's NAME_FILE counterpart could not be read.
+type FileError struct {
+	Name, Path string
+	Err        error
+}
+
+func (e *FileError) Error() string {
+	return fmt.Sprintf("%v: unreadable file: %v", e.Name, e.Err)
+}
+
+func (e *FileError) Unwrap() error {
+	return e.Err
+}
+
+// ParseError indicates that the value of a variable could not be parsed.
+type ParseError struct {
+	Name, Value string
+	// Err is the underlying error, e.g. a *strconv.NumError.
+	Err error
+	// Sensitive is set for Secret variables; Value is then empty and Err's message is reduced to the position of the
+	// offending element, if any, and a description which can't include the value, e.g. "invalid syntax".
+	Sensitive bool
+}
+
+func (e *ParseError) Error() string {
+	cause := e.Err
+	if numErr, ok := cause.(*strconv.NumError); ok {
+		cause = numErr.Err // the NumError itself repeats the value
+	}
+	if e.Sensitive {
+		return fmt.Sprintf("%v: invalid value: %v", e.Name, cause)
+	}
+	return fmt.Sprintf("%v: invalid value %q: %v", e.Name, e.Value, cause)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// valueError describes what's wrong with a value without including it, so that it may be reported for Secret variables.
+type valueError string
+
+func (e valueError) Error() string {
+	return string(e)
+}
+
+func valueErrorf(format string, args ...interface{}) error {
+	return valueError(fmt.Sprintf(format, args...))
+}
+
+// elementError locates an error within a slice or map value.
+type elementError struct {
+	pos string // e.g. "2 index" or "b key"
+	err error
+}
+
+func indexError(i int, err error) error {
+	return &elementError{pos: fmt.Sprintf("%v index", i), err: err}
+}
+
+func keyError(k string, err error) error {
+	return &elementError{pos: fmt.Sprintf("%v key", k), err: err}
+}
+
+func (e *elementError) Error() string {
+	return fmt.Sprintf("%v: %v", e.pos, e.err)
+}
+
+func (e *elementError) Unwrap() error {
+	return e.err
+}
+
+// redactedError hides the message of an error about a sensitive value, which may include the value or part of it. Only
+// the positions of any elementErrors, valueErrors and the strconv sentinels are reported; the error itself remains
+// available to errors.Is and errors.As.
+type redactedError struct {
+	err error
+}
+
+func (e *redactedError) Error() string {
+	var (
+		parts []string
+		err   = e.err
+	)
+	for el, ok := err.(*elementError); ok; el, ok = err.(*elementError) {
+		parts = append(parts, el.pos)
+		err = el.err
+	}
+	var valErr valueError
+	switch {
+	case errors.As(err, &valErr):
+		parts = append(parts, valErr.Error())
+	case errors.Is(err, strconv.ErrSyntax):
+		parts = append(parts, strconv.ErrSyntax.Error())
+	case errors.Is(err, strconv.ErrRange):
+		parts = append(parts, strconv.ErrRange.Error())
+	default:
+		parts = append(parts, "details redacted")
+	}
+	return strings.Join(parts, ": ")
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// DocOptsError indicates that the docOpts string or options for a variable were invalid; such errors are programming
+// errors rather than problems with the environment.
+type DocOptsError struct {
+	// Name is the variable name, if it could be parsed.
+	Name string
+	// Key is the offending docOpts key, if any.
+	Key string
+	Err error
+}
+
+func (e *DocOptsError) Error() string {
+	switch {
+	case e.Key != "":
+		return fmt.Sprintf("%v: invalid option %q: %v", e.Name, e.Key, e.Err)
+	case e.Name != "":
+		return fmt.Sprintf("%v: %v", e.Name, e.Err)
+	default:
+		return fmt.Sprintf("invalid docOpts: %v", e.Err)
+	}
+}
+
+func (e *DocOptsError) Unwrap() error {
+	return e.Err
+}
+
+// Errors aggregates every error encountered while extracting variables, so that all problems with an environment can be
+// reported at once. It is the error returned by Cfg.Err unless another ErrMaker is specified.
+//
+// errors.Is and errors.As match an Errors if they match any of its errors.
+type Errors []error
+
+func (e Errors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors: %v", len(e), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the aggregated errors.
+func (e Errors) Unwrap() []error {
+	return e
+}
+
+// Is reports whether any of the errors matches the target.
+func (e Errors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the errors which matches target and sets target to it.
+func (e Errors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
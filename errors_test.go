@@ -0,0 +1,265 @@
+package envcfg_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jwilner/envcfg"
+)
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       map[string]string
+		configure func(c *envcfg.Cfg)
+		wantErr   string
+		check     func(t *testing.T, err error)
+	}{
+		{
+			name:      "missing",
+			configure: func(c *envcfg.Cfg) { c.Int("A") },
+			wantErr:   "A: variable is required",
+			check: func(t *testing.T, err error) {
+				var missing *envcfg.MissingError
+				if !errors.As(err, &missing) || missing.Name != "A" {
+					t.Errorf("expected a MissingError for A, got %#v", err)
+				}
+			},
+		},
+		{
+			name:      "parse",
+			env:       map[string]string{"A": "zz"},
+			configure: func(c *envcfg.Cfg) { c.Int("A") },
+			wantErr:   `A: invalid value "zz": invalid syntax`,
+			check: func(t *testing.T, err error) {
+				var parseErr *envcfg.ParseError
+				if !errors.As(err, &parseErr) || parseErr.Name != "A" || parseErr.Value != "zz" {
+					t.Errorf("expected a ParseError for A, got %#v", err)
+				}
+				if !errors.Is(err, strconv.ErrSyntax) {
+					t.Errorf("expected %#v to wrap strconv.ErrSyntax", err)
+				}
+			},
+		},
+		{
+			name:      "parse slice",
+			env:       map[string]string{"A": "1,2,99999999999999999999"},
+			configure: func(c *envcfg.Cfg) { c.IntSlice("A") },
+			wantErr: `A: invalid value "1,2,99999999999999999999": 2 index: ` +
+				`strconv.ParseInt: parsing "99999999999999999999": value out of range`,
+			check: func(t *testing.T, err error) {
+				if !errors.Is(err, strconv.ErrRange) {
+					t.Errorf("expected %#v to wrap strconv.ErrRange", err)
+				}
+			},
+		},
+		{
+			name:      "parse time",
+			env:       map[string]string{"A": "yesterday"},
+			configure: func(c *envcfg.Cfg) { c.Time("A") },
+			wantErr: `A: invalid value "yesterday": parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": ` +
+				`cannot parse "yesterday" as "2006"`,
+			check: func(t *testing.T, err error) {
+				var timeErr *time.ParseError
+				if !errors.As(err, &timeErr) {
+					t.Errorf("expected %#v to wrap a time.ParseError", err)
+				}
+			},
+		},
+		{
+			name:      "parse secret",
+			env:       map[string]string{"A": "hunter2"},
+			configure: func(c *envcfg.Cfg) { c.Time("A secret") },
+			wantErr:   `A: invalid value: details redacted`,
+			check: func(t *testing.T, err error) {
+				var parseErr *envcfg.ParseError
+				if !errors.As(err, &parseErr) || !parseErr.Sensitive || parseErr.Value != "" {
+					t.Errorf("expected a sensitive ParseError without a value, got %#v", err)
+				}
+				var timeErr *time.ParseError
+				if !errors.As(err, &timeErr) {
+					t.Errorf("expected %#v to wrap a time.ParseError", err)
+				}
+			},
+		},
+		{
+			name:      "parse secret int",
+			env:       map[string]string{"A": "hunter2"},
+			configure: func(c *envcfg.Cfg) { c.Int("A", envcfg.Secret) },
+			wantErr:   `A: invalid value: invalid syntax`,
+			check: func(t *testing.T, err error) {
+				if !errors.Is(err, strconv.ErrSyntax) {
+					t.Errorf("expected %#v to wrap strconv.ErrSyntax", err)
+				}
+			},
+		},
+		{
+			name:      "parse secret slice",
+			env:       map[string]string{"A": "1,hunter2"},
+			configure: func(c *envcfg.Cfg) { c.IntSlice("A secret") },
+			wantErr:   `A: invalid value: 1 index: invalid syntax`,
+			check: func(t *testing.T, err error) {
+				if !errors.Is(err, strconv.ErrSyntax) {
+					t.Errorf("expected %#v to wrap strconv.ErrSyntax", err)
+				}
+				var numErr *strconv.NumError
+				if !errors.As(err, &numErr) || numErr.Num != "hunter2" {
+					t.Errorf("expected %#v to wrap the NumError", err)
+				}
+			},
+		},
+		{
+			name:      "parse secret map",
+			env:       map[string]string{"A": "a=1,b=99999999999999999999"},
+			configure: func(c *envcfg.Cfg) { c.IntMap("A secret") },
+			wantErr:   `A: invalid value: b key: value out of range`,
+			check: func(t *testing.T, err error) {
+				if !errors.Is(err, strconv.ErrRange) {
+					t.Errorf("expected %#v to wrap strconv.ErrRange", err)
+				}
+			},
+		},
+		{
+			name:      "parse secret IPs",
+			env:       map[string]string{"A": "10.0.0.1,hunter2"},
+			configure: func(c *envcfg.Cfg) { c.IPSlice("A secret") },
+			wantErr:   `A: invalid value: 1 index: invalid IP`,
+		},
+		{
+			name:      "parse secret pairs",
+			env:       map[string]string{"A": "hunter2"},
+			configure: func(c *envcfg.Cfg) { c.StringMap("A secret") },
+			wantErr:   `A: invalid value: 0 index: missing separator '='`,
+		},
+		{
+			name:      "secret bad default",
+			configure: func(c *envcfg.Cfg) { c.Int("A secret default=hunter2") },
+			wantErr:   `A: invalid option "default": invalid syntax`,
+			check: func(t *testing.T, err error) {
+				if !errors.Is(err, strconv.ErrSyntax) {
+					t.Errorf("expected %#v to wrap strconv.ErrSyntax", err)
+				}
+			},
+		},
+		{
+			name:      "unknown option",
+			configure: func(c *envcfg.Cfg) { c.Int("A bass=16") },
+			wantErr:   `A: invalid option "bass": unknown option`,
+			check: func(t *testing.T, err error) {
+				var docErr *envcfg.DocOptsError
+				if !errors.As(err, &docErr) || docErr.Name != "A" || docErr.Key != "bass" {
+					t.Errorf("expected a DocOptsError for A's bass, got %#v", err)
+				}
+				if !errors.Is(err, envcfg.ErrUnknownOption) {
+					t.Errorf("expected %#v to wrap ErrUnknownOption", err)
+				}
+			},
+		},
+		{
+			name:      "bad option",
+			configure: func(c *envcfg.Cfg) { c.IntSlice("A comma=ab") },
+			wantErr:   `A: invalid option "comma": must be only one rune`,
+		},
+		{
+			name:      "bad default",
+			configure: func(c *envcfg.Cfg) { c.Int("A default=zz base=10") },
+			wantErr:   `A: invalid option "default": strconv.ParseInt: parsing "zz": invalid syntax`,
+			check: func(t *testing.T, err error) {
+				var docErr *envcfg.DocOptsError
+				if !errors.As(err, &docErr) || docErr.Key != "default" {
+					t.Errorf("expected a DocOptsError for A's default, got %#v", err)
+				}
+			},
+		},
+		{
+			name:      "default out of bounds",
+			configure: func(c *envcfg.Cfg) { c.Uint("A max=10 default=11") },
+			wantErr:   `A: invalid option "default": must be at most 10`,
+		},
+		{
+			name:      "bad bound",
+			configure: func(c *envcfg.Cfg) { c.Float("A min=small") },
+			wantErr:   `A: invalid option "min": strconv.ParseFloat: parsing "small": invalid syntax`,
+		},
+		{
+			name:      "bad regex",
+			configure: func(c *envcfg.Cfg) { c.String("A", envcfg.StringRegex("[")) },
+			wantErr:   "A: invalid option \"regex\": error parsing regexp: missing closing ]: `[`",
+		},
+		{
+			name:      "no name",
+			configure: func(c *envcfg.Cfg) { c.Custom(" ", nil) },
+			wantErr:   "invalid docOpts: doc must contain at least name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := envcfg.New(
+				envcfg.Panic(false),
+				envcfg.EnvFunc(func(k string) (string, bool) {
+					v, ok := tt.env[k]
+					return v, ok
+				}),
+			)
+			tt.configure(c)
+
+			err := c.Err()
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("Err() = %v, want %v", err, tt.wantErr)
+			}
+			if tt.check != nil {
+				tt.check(t, err)
+			}
+		})
+	}
+}
+
+func TestErrors_aggregate(t *testing.T) {
+	c := envcfg.New( // errors are collected by default
+		envcfg.EnvFunc(func(k string) (string, bool) {
+			v, ok := map[string]string{"B": "zz"}[k]
+			return v, ok
+		}),
+	)
+	_ = c.Int("A")
+	_ = c.Int("B")
+	_ = c.Int("C default=1")
+	_ = c.Duration("D")
+
+	err := c.Err()
+	want := `3 errors: A: variable is required; B: invalid value "zz": invalid syntax; D: variable is required`
+	if err == nil || err.Error() != want {
+		t.Fatalf("Err() = %v, want %v", err, want)
+	}
+
+	var errs envcfg.Errors
+	if !errors.As(err, &errs) || len(errs) != 3 {
+		t.Fatalf("expected %#v to be 3 Errors", err)
+	}
+	var missing *envcfg.MissingError
+	if !errors.As(err, &missing) || missing.Name != "A" {
+		t.Errorf("expected %#v to contain a MissingError for A", err)
+	}
+	var parseErr *envcfg.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Name != "B" {
+		t.Errorf("expected %#v to contain a ParseError for B", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected %#v to wrap strconv.ErrSyntax", err)
+	}
+}
+
+func TestErrors_panic(t *testing.T) {
+	c := envcfg.New(envcfg.Panic(true), envcfg.EnvFunc(func(string) (string, bool) { return "", false }))
+	defer func() {
+		var missing *envcfg.MissingError
+		if err, _ := recover().(error); !errors.As(err, &missing) || missing.Name != "A" {
+			t.Errorf("expected a panic with a MissingError for A, got %v", err)
+		}
+	}()
+	_ = c.Int("A")
+	_ = c.Int("B")
+	t.Error("expected a panic on the first error")
+}
@@ -29,6 +29,7 @@ func ExampleCfg_Int() {
 	//     "params": {
 	//         "base": 16
 	//     },
-	//     "comment": "A hex int configuration value"
+	//     "comment": "A hex int configuration value",
+	//     "provenance": "env"
 	//}
 }
@@ -3,7 +3,6 @@ package envcfg
 // Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
 
 import (
-	"errors"
 	"strconv"
 	"strings"
 )
@@ -17,18 +16,19 @@ import (
 // Available options:
 // 		- "bit_size" or FloatBitSize
 // 		- "default" or FloatDefault
+// 		- "file_ok" or FileOK
+// 		- "max" or FloatMax
+// 		- "min" or FloatMin
 // 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 func (c *Cfg) Float(docOpts string, opts ...FloatOpt) (v float64) {
 	s, err := newFloatSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).(float64)
+	v, _ = c.register(s).(float64)
 	return
 }
 
@@ -50,6 +50,20 @@ func FloatDefault(def float64) FloatOpt {
 	return defaultOpt(def)
 }
 
+// FloatMax specifies the maximum permitted value for a Float variable.
+func FloatMax(max float64) FloatOpt {
+	return floatOptFunc(func(p *floatParser) {
+		p.max = &max
+	})
+}
+
+// FloatMin specifies the minimum permitted value for a Float variable.
+func FloatMin(min float64) FloatOpt {
+	return floatOptFunc(func(p *floatParser) {
+		p.min = &min
+	})
+}
+
 type floatOptFunc func(p *floatParser)
 
 func (f floatOptFunc) modifyFloatParser(p *floatParser) {
@@ -63,7 +77,7 @@ var _ FloatOpt = new(floatOptFunc)
 func newFloatSpec(docOpts string, opts []FloatOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(floatParser)
@@ -74,33 +88,31 @@ func newFloatSpec(docOpts string, opts []FloatOpt) (*spec, error) {
 		comment:  parsed.description,
 	}
 
+	values := make(map[string]string)
 	for _, f := range parsed.fields {
 		var (
 			opt FloatOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
+		switch key := strings.ToLower(f[0]); key {
 		case "bit_size":
 			var val int
 			val, err = strconv.Atoi(f[1])
 			opt = FloatBitSize(val)
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
-		case "optional":
-			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
-			}
-			opt = Optional
+		case "max":
+			values[key] = f[1]
+			continue
+		case "min":
+			values[key] = f[1]
+			continue
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyFloatParser(p)
@@ -111,9 +123,35 @@ func newFloatSpec(docOpts string, opts []FloatOpt) (*spec, error) {
 		opt.modifyFloatParser(p)
 	}
 
+	// value params are parsed once the options they depend on are known, but typed options take precedence
+	if val, ok := values["max"]; ok {
+		v, err := p.parse(val)
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "max", Err: err}
+		}
+		if p.max == nil {
+			max := v.(float64)
+			p.max = &max
+		}
+	}
+
+	if val, ok := values["min"]; ok {
+		v, err := p.parse(val)
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "min", Err: err}
+		}
+		if p.min == nil {
+			min := v.(float64)
+			p.min = &min
+		}
+	}
+
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -122,21 +160,54 @@ func newFloatSpec(docOpts string, opts []FloatOpt) (*spec, error) {
 
 type floatParser struct {
 	bitSize int
+	max     *float64
+	min     *float64
 }
 
 func (p *floatParser) parse(s string) (interface{}, error) {
-	return strconv.ParseFloat(
+	v, err := strconv.ParseFloat(
 		s,
 		p.bitSize,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validate(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *floatParser) validate(v float64) error {
+	if p.min != nil && v < *p.min {
+		return valueErrorf("must be at least %v", p.format(*p.min))
+	}
+	if p.max != nil && v > *p.max {
+		return valueErrorf("must be at most %v", p.format(*p.max))
+	}
+	return nil
+}
+
+func (p *floatParser) format(val interface{}) string {
+	v, _ := val.(float64)
+	return formatFloat(v, p.bitSize)
 }
 
 func (p *floatParser) describe() interface{} {
-	return floatParserDescription{
+	d := floatParserDescription{
 		BitSize: p.bitSize,
 	}
+	if p.max != nil {
+		d.Max = p.format(*p.max)
+	}
+	if p.min != nil {
+		d.Min = p.format(*p.min)
+	}
+	return d
 }
 
 type floatParserDescription struct {
-	BitSize int `json:"bit_size,omitempty"`
+	BitSize int    `json:"bit_size,omitempty"`
+	Max     string `json:"max,omitempty"`
+	Min     string `json:"min,omitempty"`
 }
@@ -0,0 +1,238 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// FloatSlice extracts and parses a []float64 variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe FloatSliceOpts.
+//
+// Available options:
+// 		- "bit_size" or FloatSliceBitSize
+// 		- "comma" or FloatSliceComma
+// 		- "default" or FloatSliceDefault
+// 		- "file_ok" or FileOK
+// 		- "max_len" or FloatSliceMaxLen
+// 		- "min_len" or FloatSliceMinLen
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) FloatSlice(docOpts string, opts ...FloatSliceOpt) (v []float64) {
+	s, err := newFloatSliceSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).([]float64)
+	return
+}
+
+// FloatSliceOpt modifies FloatSlice variable configuration.
+type FloatSliceOpt interface {
+	modify(s *spec)
+	modifyFloatSliceParser(p *floatSliceParser)
+}
+
+// FloatSliceBitSize specifies the bit size to use for a FloatSlice variable.
+func FloatSliceBitSize(bitSize int) FloatSliceOpt {
+	return floatSliceOptFunc(func(p *floatSliceParser) {
+		p.bitSize = bitSize
+	})
+}
+
+// FloatSliceComma specifies the comma to use for a FloatSlice variable.
+func FloatSliceComma(comma rune) FloatSliceOpt {
+	return floatSliceOptFunc(func(p *floatSliceParser) {
+		p.comma = comma
+	})
+}
+
+// FloatSliceDefault specifies a default value for a FloatSlice variable.
+func FloatSliceDefault(def []float64) FloatSliceOpt {
+	return defaultOpt(def)
+}
+
+// FloatSliceMaxLen specifies the maximum permitted length for a FloatSlice variable.
+func FloatSliceMaxLen(maxLen int) FloatSliceOpt {
+	return floatSliceOptFunc(func(p *floatSliceParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// FloatSliceMinLen specifies the minimum permitted length for a FloatSlice variable.
+func FloatSliceMinLen(minLen int) FloatSliceOpt {
+	return floatSliceOptFunc(func(p *floatSliceParser) {
+		p.minLen = minLen
+	})
+}
+
+type floatSliceOptFunc func(p *floatSliceParser)
+
+func (f floatSliceOptFunc) modifyFloatSliceParser(p *floatSliceParser) {
+	f(p)
+}
+
+func (floatSliceOptFunc) modify(*spec) {}
+
+var _ FloatSliceOpt = new(floatSliceOptFunc)
+
+func newFloatSliceSpec(docOpts string, opts []FloatSliceOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(floatSliceParser)
+	s := &spec{
+		parser:   p,
+		typeName: "[]float64",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt FloatSliceOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "bit_size":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = FloatSliceBitSize(val)
+		case "comma":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = FloatSliceComma(value[0])
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = FloatSliceMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = FloatSliceMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyFloatSliceParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyFloatSliceParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type floatSliceParser struct {
+	bitSize int
+	comma   rune
+	maxLen  int
+	minLen  int
+}
+
+func (p *floatSliceParser) parse(s string) (interface{}, error) {
+	ses, err := parseSlice(s, p.comma)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make([]float64, len(ses))
+	for i, v := range ses {
+		el, err := strconv.ParseFloat(
+			v,
+			p.bitSize,
+		)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		vals[i] = el
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+
+}
+
+func (p *floatSliceParser) validate(v []float64) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *floatSliceParser) format(val interface{}) string {
+	vals, _ := val.([]float64)
+	ses := make([]string, len(vals))
+	for i, v := range vals {
+		ses[i] = formatFloat(v, p.bitSize)
+	}
+	return formatSlice(ses, p.comma)
+}
+
+func (p *floatSliceParser) describe() interface{} {
+	return floatSliceParserDescription{
+		BitSize: p.bitSize,
+		Comma:   p.comma,
+		MaxLen:  p.maxLen,
+		MinLen:  p.minLen,
+	}
+}
+
+type floatSliceParserDescription struct {
+	BitSize int  `json:"bit_size,omitempty"`
+	Comma   rune `json:"comma,omitempty"`
+	MaxLen  int  `json:"max_len,omitempty"`
+	MinLen  int  `json:"min_len,omitempty"`
+}
+
+func (d floatSliceParserDescription) MarshalJSON() ([]byte, error) {
+	var comma string
+	if d.Comma != 0 {
+		comma = string(d.Comma)
+	}
+	return json.Marshal(struct {
+		BitSize int    `json:"bit_size,omitempty"`
+		Comma   string `json:"comma,omitempty"`
+		MaxLen  int    `json:"max_len,omitempty"`
+		MinLen  int    `json:"min_len,omitempty"`
+	}{
+		BitSize: d.BitSize,
+		Comma:   comma,
+		MaxLen:  d.MaxLen,
+		MinLen:  d.MinLen,
+	})
+}
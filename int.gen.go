@@ -3,7 +3,6 @@ package envcfg
 // Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
 
 import (
-	"errors"
 	"strconv"
 	"strings"
 )
@@ -18,18 +17,19 @@ import (
 // 		- "base" or IntBase
 // 		- "bit_size" or IntBitSize
 // 		- "default" or IntDefault
+// 		- "file_ok" or FileOK
+// 		- "max" or IntMax
+// 		- "min" or IntMin
 // 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 func (c *Cfg) Int(docOpts string, opts ...IntOpt) (v int64) {
 	s, err := newIntSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).(int64)
+	v, _ = c.register(s).(int64)
 	return
 }
 
@@ -58,6 +58,20 @@ func IntDefault(def int64) IntOpt {
 	return defaultOpt(def)
 }
 
+// IntMax specifies the maximum permitted value for a Int variable.
+func IntMax(max int64) IntOpt {
+	return intOptFunc(func(p *intParser) {
+		p.max = &max
+	})
+}
+
+// IntMin specifies the minimum permitted value for a Int variable.
+func IntMin(min int64) IntOpt {
+	return intOptFunc(func(p *intParser) {
+		p.min = &min
+	})
+}
+
 type intOptFunc func(p *intParser)
 
 func (f intOptFunc) modifyIntParser(p *intParser) {
@@ -71,7 +85,7 @@ var _ IntOpt = new(intOptFunc)
 func newIntSpec(docOpts string, opts []IntOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(intParser)
@@ -82,12 +96,13 @@ func newIntSpec(docOpts string, opts []IntOpt) (*spec, error) {
 		comment:  parsed.description,
 	}
 
+	values := make(map[string]string)
 	for _, f := range parsed.fields {
 		var (
 			opt IntOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
+		switch key := strings.ToLower(f[0]); key {
 		case "base":
 			var val int
 			val, err = strconv.Atoi(f[1])
@@ -96,23 +111,20 @@ func newIntSpec(docOpts string, opts []IntOpt) (*spec, error) {
 			var val int
 			val, err = strconv.Atoi(f[1])
 			opt = IntBitSize(val)
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
-		case "optional":
-			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
-			}
-			opt = Optional
+		case "max":
+			values[key] = f[1]
+			continue
+		case "min":
+			values[key] = f[1]
+			continue
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyIntParser(p)
@@ -123,9 +135,35 @@ func newIntSpec(docOpts string, opts []IntOpt) (*spec, error) {
 		opt.modifyIntParser(p)
 	}
 
+	// value params are parsed once the options they depend on are known, but typed options take precedence
+	if val, ok := values["max"]; ok {
+		v, err := p.parse(val)
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "max", Err: err}
+		}
+		if p.max == nil {
+			max := v.(int64)
+			p.max = &max
+		}
+	}
+
+	if val, ok := values["min"]; ok {
+		v, err := p.parse(val)
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "min", Err: err}
+		}
+		if p.min == nil {
+			min := v.(int64)
+			p.min = &min
+		}
+	}
+
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -135,24 +173,57 @@ func newIntSpec(docOpts string, opts []IntOpt) (*spec, error) {
 type intParser struct {
 	base    int
 	bitSize int
+	max     *int64
+	min     *int64
 }
 
 func (p *intParser) parse(s string) (interface{}, error) {
-	return strconv.ParseInt(
+	v, err := strconv.ParseInt(
 		s,
 		p.base,
 		p.bitSize,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validate(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *intParser) validate(v int64) error {
+	if p.min != nil && v < *p.min {
+		return valueErrorf("must be at least %v", p.format(*p.min))
+	}
+	if p.max != nil && v > *p.max {
+		return valueErrorf("must be at most %v", p.format(*p.max))
+	}
+	return nil
+}
+
+func (p *intParser) format(val interface{}) string {
+	v, _ := val.(int64)
+	return formatInt(v, p.base)
 }
 
 func (p *intParser) describe() interface{} {
-	return intParserDescription{
+	d := intParserDescription{
 		Base:    p.base,
 		BitSize: p.bitSize,
 	}
+	if p.max != nil {
+		d.Max = p.format(*p.max)
+	}
+	if p.min != nil {
+		d.Min = p.format(*p.min)
+	}
+	return d
 }
 
 type intParserDescription struct {
-	Base    int `json:"base,omitempty"`
-	BitSize int `json:"bit_size,omitempty"`
+	Base    int    `json:"base,omitempty"`
+	BitSize int    `json:"bit_size,omitempty"`
+	Max     string `json:"max,omitempty"`
+	Min     string `json:"min,omitempty"`
 }
@@ -0,0 +1,280 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// IntMap extracts and parses a map[string]int64 variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe IntMapOpts.
+//
+// Available options:
+// 		- "base" or IntMapBase
+// 		- "bit_size" or IntMapBitSize
+// 		- "comma" or IntMapComma
+// 		- "default" or IntMapDefault
+// 		- "file_ok" or FileOK
+// 		- "key_value_sep" or IntMapKeyValueSep
+// 		- "max_len" or IntMapMaxLen
+// 		- "min_len" or IntMapMinLen
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) IntMap(docOpts string, opts ...IntMapOpt) (v map[string]int64) {
+	s, err := newIntMapSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).(map[string]int64)
+	return
+}
+
+// IntMapOpt modifies IntMap variable configuration.
+type IntMapOpt interface {
+	modify(s *spec)
+	modifyIntMapParser(p *intMapParser)
+}
+
+// IntMapBase specifies the base to use for a IntMap variable.
+func IntMapBase(base int) IntMapOpt {
+	return intMapOptFunc(func(p *intMapParser) {
+		p.base = base
+	})
+}
+
+// IntMapBitSize specifies the bit size to use for a IntMap variable.
+func IntMapBitSize(bitSize int) IntMapOpt {
+	return intMapOptFunc(func(p *intMapParser) {
+		p.bitSize = bitSize
+	})
+}
+
+// IntMapComma specifies the comma to use for a IntMap variable.
+func IntMapComma(comma rune) IntMapOpt {
+	return intMapOptFunc(func(p *intMapParser) {
+		p.comma = comma
+	})
+}
+
+// IntMapDefault specifies a default value for a IntMap variable.
+func IntMapDefault(def map[string]int64) IntMapOpt {
+	return defaultOpt(def)
+}
+
+// IntMapKeyValueSep specifies the separator between keys and values for a IntMap variable.
+func IntMapKeyValueSep(keyValueSep rune) IntMapOpt {
+	return intMapOptFunc(func(p *intMapParser) {
+		p.keyValueSep = keyValueSep
+	})
+}
+
+// IntMapMaxLen specifies the maximum permitted length for a IntMap variable.
+func IntMapMaxLen(maxLen int) IntMapOpt {
+	return intMapOptFunc(func(p *intMapParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// IntMapMinLen specifies the minimum permitted length for a IntMap variable.
+func IntMapMinLen(minLen int) IntMapOpt {
+	return intMapOptFunc(func(p *intMapParser) {
+		p.minLen = minLen
+	})
+}
+
+type intMapOptFunc func(p *intMapParser)
+
+func (f intMapOptFunc) modifyIntMapParser(p *intMapParser) {
+	f(p)
+}
+
+func (intMapOptFunc) modify(*spec) {}
+
+var _ IntMapOpt = new(intMapOptFunc)
+
+func newIntMapSpec(docOpts string, opts []IntMapOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(intMapParser)
+	s := &spec{
+		parser:   p,
+		typeName: "map[string]int64",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt IntMapOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "base":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = IntMapBase(val)
+		case "bit_size":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = IntMapBitSize(val)
+		case "comma":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = IntMapComma(value[0])
+		case "key_value_sep":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = IntMapKeyValueSep(value[0])
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = IntMapMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = IntMapMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyIntMapParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyIntMapParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type intMapParser struct {
+	base        int
+	bitSize     int
+	comma       rune
+	keyValueSep rune
+	maxLen      int
+	minLen      int
+}
+
+func (p *intMapParser) parse(s string) (interface{}, error) {
+	pairs, err := parsePairs(s, p.comma, p.keyValueSep)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make(map[string]int64, len(pairs))
+	for _, kv := range pairs {
+		k, v := kv[0], kv[1]
+		el, err := strconv.ParseInt(
+			v,
+			p.base,
+			p.bitSize,
+		)
+		if err != nil {
+			return nil, keyError(k, err)
+		}
+		vals[k] = el
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+}
+
+func (p *intMapParser) validate(v map[string]int64) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *intMapParser) format(val interface{}) string {
+	vals, _ := val.(map[string]int64)
+	ses := make(map[string]string, len(vals))
+	for k, v := range vals {
+		ses[k] = formatInt(v, p.base)
+	}
+	return formatPairs(ses, p.comma, p.keyValueSep)
+}
+
+func (p *intMapParser) describe() interface{} {
+	return intMapParserDescription{
+		Base:        p.base,
+		BitSize:     p.bitSize,
+		Comma:       p.comma,
+		KeyValueSep: p.keyValueSep,
+		MaxLen:      p.maxLen,
+		MinLen:      p.minLen,
+	}
+}
+
+type intMapParserDescription struct {
+	Base        int  `json:"base,omitempty"`
+	BitSize     int  `json:"bit_size,omitempty"`
+	Comma       rune `json:"comma,omitempty"`
+	KeyValueSep rune `json:"key_value_sep,omitempty"`
+	MaxLen      int  `json:"max_len,omitempty"`
+	MinLen      int  `json:"min_len,omitempty"`
+}
+
+func (d intMapParserDescription) MarshalJSON() ([]byte, error) {
+	var comma string
+	if d.Comma != 0 {
+		comma = string(d.Comma)
+	}
+	var keyValueSep string
+	if d.KeyValueSep != 0 {
+		keyValueSep = string(d.KeyValueSep)
+	}
+	return json.Marshal(struct {
+		Base        int    `json:"base,omitempty"`
+		BitSize     int    `json:"bit_size,omitempty"`
+		Comma       string `json:"comma,omitempty"`
+		KeyValueSep string `json:"key_value_sep,omitempty"`
+		MaxLen      int    `json:"max_len,omitempty"`
+		MinLen      int    `json:"min_len,omitempty"`
+	}{
+		Base:        d.Base,
+		BitSize:     d.BitSize,
+		Comma:       comma,
+		KeyValueSep: keyValueSep,
+		MaxLen:      d.MaxLen,
+		MinLen:      d.MinLen,
+	})
+}
@@ -5,7 +5,6 @@ package envcfg
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"strconv"
 	"strings"
 )
@@ -21,18 +20,19 @@ import (
 // 		- "bit_size" or IntSliceBitSize
 // 		- "comma" or IntSliceComma
 // 		- "default" or IntSliceDefault
+// 		- "file_ok" or FileOK
+// 		- "max_len" or IntSliceMaxLen
+// 		- "min_len" or IntSliceMinLen
 // 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 func (c *Cfg) IntSlice(docOpts string, opts ...IntSliceOpt) (v []int64) {
 	s, err := newIntSliceSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).([]int64)
+	v, _ = c.register(s).([]int64)
 	return
 }
 
@@ -68,6 +68,20 @@ func IntSliceDefault(def []int64) IntSliceOpt {
 	return defaultOpt(def)
 }
 
+// IntSliceMaxLen specifies the maximum permitted length for a IntSlice variable.
+func IntSliceMaxLen(maxLen int) IntSliceOpt {
+	return intSliceOptFunc(func(p *intSliceParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// IntSliceMinLen specifies the minimum permitted length for a IntSlice variable.
+func IntSliceMinLen(minLen int) IntSliceOpt {
+	return intSliceOptFunc(func(p *intSliceParser) {
+		p.minLen = minLen
+	})
+}
+
 type intSliceOptFunc func(p *intSliceParser)
 
 func (f intSliceOptFunc) modifyIntSliceParser(p *intSliceParser) {
@@ -81,7 +95,7 @@ var _ IntSliceOpt = new(intSliceOptFunc)
 func newIntSliceSpec(docOpts string, opts []IntSliceOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(intSliceParser)
@@ -97,7 +111,7 @@ func newIntSliceSpec(docOpts string, opts []IntSliceOpt) (*spec, error) {
 			opt IntSliceOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
+		switch key := strings.ToLower(f[0]); key {
 		case "base":
 			var val int
 			val, err = strconv.Atoi(f[1])
@@ -113,23 +127,22 @@ func newIntSliceSpec(docOpts string, opts []IntSliceOpt) (*spec, error) {
 				break
 			}
 			opt = IntSliceComma(value[0])
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
-		case "optional":
-			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
-			}
-			opt = Optional
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = IntSliceMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = IntSliceMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyIntSliceParser(p)
@@ -142,7 +155,10 @@ func newIntSliceSpec(docOpts string, opts []IntSliceOpt) (*spec, error) {
 
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -153,6 +169,8 @@ type intSliceParser struct {
 	base    int
 	bitSize int
 	comma   rune
+	maxLen  int
+	minLen  int
 }
 
 func (p *intSliceParser) parse(s string) (interface{}, error) {
@@ -169,19 +187,43 @@ func (p *intSliceParser) parse(s string) (interface{}, error) {
 			p.bitSize,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("%v index: %v", i, err)
+			return nil, indexError(i, err)
 		}
 		vals[i] = el
 	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
 	return vals, nil
 
 }
 
+func (p *intSliceParser) validate(v []int64) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *intSliceParser) format(val interface{}) string {
+	vals, _ := val.([]int64)
+	ses := make([]string, len(vals))
+	for i, v := range vals {
+		ses[i] = formatInt(v, p.base)
+	}
+	return formatSlice(ses, p.comma)
+}
+
 func (p *intSliceParser) describe() interface{} {
 	return intSliceParserDescription{
 		Base:    p.base,
 		BitSize: p.bitSize,
 		Comma:   p.comma,
+		MaxLen:  p.maxLen,
+		MinLen:  p.minLen,
 	}
 }
 
@@ -189,6 +231,8 @@ type intSliceParserDescription struct {
 	Base    int  `json:"base,omitempty"`
 	BitSize int  `json:"bit_size,omitempty"`
 	Comma   rune `json:"comma,omitempty"`
+	MaxLen  int  `json:"max_len,omitempty"`
+	MinLen  int  `json:"min_len,omitempty"`
 }
 
 func (d intSliceParserDescription) MarshalJSON() ([]byte, error) {
@@ -200,9 +244,13 @@ func (d intSliceParserDescription) MarshalJSON() ([]byte, error) {
 		Base    int    `json:"base,omitempty"`
 		BitSize int    `json:"bit_size,omitempty"`
 		Comma   string `json:"comma,omitempty"`
+		MaxLen  int    `json:"max_len,omitempty"`
+		MinLen  int    `json:"min_len,omitempty"`
 	}{
 		Base:    d.Base,
 		BitSize: d.BitSize,
 		Comma:   comma,
+		MaxLen:  d.MaxLen,
+		MinLen:  d.MinLen,
 	})
 }
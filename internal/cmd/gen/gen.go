@@ -17,6 +17,8 @@ const (
 	global = 1 << iota
 	field
 	parseParam
+	valueParam // the param is a value of the spec's type
+	validation // the param constrains parsed values
 )
 
 var (
@@ -26,24 +28,101 @@ var (
 	bitSize      = param{"BitSize", "int", "", "0", "strconv", "specifies the bit size to use", field | parseParam}
 	layout       = param{"Layout", "string", "time.RFC3339", `""`, "", "specifies the layout to use", field | parseParam}
 	comma        = param{"Comma", "rune", "", "0", "", "specifies the comma to use", field}
+	keyValueSep  = param{"KeyValueSep", "rune", "", "0", "", "specifies the separator between keys and values", field}
 	b64Padding   = param{"Padding", "rune", "", "0", "", "specifies an alternate padding", field | parseParam}
 	b64NoPadding = param{"NoPadding", "bool", "", "false", "strconv", "disables padding", field | parseParam}
 	b64URLSafe   = param{"URLSafe", "bool", "", "false", "strconv", "specifies the URL safe form of base64 encoding", field | parseParam}
 
+	schemes     = param{"Schemes", "[]string", "", "nil", "", "specifies the permitted schemes", field | parseParam}
+	requireHost = param{"RequireHost", "bool", "", "false", "strconv", "specifies that a host is required", field | parseParam}
+
+	iec = param{"IEC", "bool", "", "false", "strconv", "specifies that units such as KB are powers of 1024", field | parseParam}
+
+	ipv4 = param{"IPv4", "bool", "", "false", "strconv", "restricts values to IPv4 addresses", field | parseParam}
+	ipv6 = param{"IPv6", "bool", "", "false", "strconv", "restricts values to IPv6 addresses", field | parseParam}
+
+	min    = param{"Min", "", "", "", "", "specifies the minimum permitted value", field | valueParam | validation}
+	max    = param{"Max", "", "", "", "", "specifies the maximum permitted value", field | valueParam | validation}
+	minLen = param{"MinLen", "int", "", "0", "strconv", "specifies the minimum permitted length", field | validation}
+	maxLen = param{"MaxLen", "int", "", "0", "strconv", "specifies the maximum permitted length", field | validation}
+	oneOf  = param{"OneOf", "[]string", "", "nil", "", "specifies the permitted values", field | validation}
+	regex  = param{"Regex", "string", "", `""`, "regexp", "specifies a regular expression values must match", field | validation}
+
 	optional = param{"Optional", "", "", "", "", "specifies that the option is not required", global}
+	secret   = param{"Secret", "", "", "", "", "specifies that the value is sensitive", global}
+	fileOK   = param{"FileOK", "", "", "", "", "allows the value to be read from a file named by NAME_FILE", global}
+	reload   = param{"Reloadable", "", "", "", "", "specifies that the value may change while running", global}
 
 	types = []specCfg{
-		{"Bool", "bool", "strconv.ParseBool", []string{"strconv"}, []param{placeholder}},
-		{"Bytes", "[]byte", "parseBytes", nil, []param{placeholder, b64Padding, b64NoPadding, b64URLSafe}},
-		{"Duration", "time.Duration", "time.ParseDuration", []string{"time"}, []param{placeholder}},
-		{"Float", "float64", "strconv.ParseFloat", []string{"strconv"}, []param{placeholder, bitSize}},
-		{"Int", "int64", "strconv.ParseInt", []string{"strconv"}, []param{placeholder, base, bitSize}},
-		{"IntSlice", "[]int64", "strconv.ParseInt", []string{"strconv"}, []param{placeholder, base, bitSize}},
-		{"IP", "net.IP", "parseIP", []string{"net"}, []param{placeholder}},
-		{"String", "string", "", nil, nil},
-		{"StringSlice", "[]string", "", nil, nil},
-		{"Time", "time.Time", "time.Parse", []string{"time"}, []param{layout, placeholder}},
-		{"Uint", "uint64", "strconv.ParseUint", []string{"strconv"}, []param{placeholder, base, bitSize}},
+		{"Bool", "bool", "strconv.ParseBool", "strconv.FormatBool(v)", []string{"strconv"}, []param{placeholder}},
+		{
+			"BoolSlice", "[]bool", "strconv.ParseBool", "strconv.FormatBool(v)", []string{"strconv"},
+			[]param{placeholder, minLen, maxLen},
+		},
+		{
+			"ByteSize", "ByteSize", "parseByteSize", "formatByteSize(v, p.iec)", nil,
+			[]param{placeholder, iec, min, max},
+		},
+		{
+			"Bytes", "[]byte", "parseBytes", "formatBytes(v, p.padding, p.noPadding, p.urlSafe)", nil,
+			[]param{placeholder, b64Padding, b64NoPadding, b64URLSafe, minLen, maxLen},
+		},
+		{"Duration", "time.Duration", "time.ParseDuration", "v.String()", []string{"time"}, []param{placeholder, min, max}},
+		{
+			"DurationMap", "map[string]time.Duration", "time.ParseDuration", "v.String()", []string{"time"},
+			[]param{placeholder, minLen, maxLen},
+		},
+		{
+			"DurationSlice", "[]time.Duration", "time.ParseDuration", "v.String()", []string{"time"},
+			[]param{placeholder, minLen, maxLen},
+		},
+		{
+			"Float", "float64", "strconv.ParseFloat", "formatFloat(v, p.bitSize)", []string{"strconv"},
+			[]param{placeholder, bitSize, min, max},
+		},
+		{
+			"FloatSlice", "[]float64", "strconv.ParseFloat", "formatFloat(v, p.bitSize)", []string{"strconv"},
+			[]param{placeholder, bitSize, minLen, maxLen},
+		},
+		{
+			"Int", "int64", "strconv.ParseInt", "formatInt(v, p.base)", []string{"strconv"},
+			[]param{placeholder, base, bitSize, min, max},
+		},
+		{
+			"IntMap", "map[string]int64", "strconv.ParseInt", "formatInt(v, p.base)", []string{"strconv"},
+			[]param{placeholder, base, bitSize, minLen, maxLen},
+		},
+		{
+			"IntSlice", "[]int64", "strconv.ParseInt", "formatInt(v, p.base)", []string{"strconv"},
+			[]param{placeholder, base, bitSize, minLen, maxLen},
+		},
+		{"IP", "net.IP", "parseIP", "v.String()", []string{"net"}, []param{placeholder, ipv4, ipv6}},
+		{"IPNet", "*net.IPNet", "parseIPNet", "v.String()", []string{"net"}, []param{placeholder, ipv4, ipv6}},
+		{
+			"IPNetSlice", "[]*net.IPNet", "parseIPNet", "v.String()", []string{"net"},
+			[]param{placeholder, ipv4, ipv6, minLen, maxLen},
+		},
+		{
+			"IPSlice", "[]net.IP", "parseIP", "v.String()", []string{"net"},
+			[]param{placeholder, ipv4, ipv6, minLen, maxLen},
+		},
+		{"String", "string", "", "v", nil, []param{oneOf, regex}},
+		{"StringMap", "map[string]string", "", "v", nil, []param{minLen, maxLen}},
+		{"StringSlice", "[]string", "", "v", nil, []param{minLen, maxLen}},
+		{"Time", "time.Time", "time.Parse", "formatTime(v, p.layout)", []string{"time"}, []param{layout, placeholder}},
+		{
+			"TimeSlice", "[]time.Time", "time.Parse", "formatTime(v, p.layout)", []string{"time"},
+			[]param{layout, placeholder, minLen, maxLen},
+		},
+		{"URL", "*url.URL", "parseURL", "formatURL(v)", []string{"net/url"}, []param{placeholder, schemes, requireHost}},
+		{
+			"Uint", "uint64", "strconv.ParseUint", "formatUint(v, p.base)", []string{"strconv"},
+			[]param{placeholder, base, bitSize, min, max},
+		},
+		{
+			"UintSlice", "[]uint64", "strconv.ParseUint", "formatUint(v, p.base)", []string{"strconv"},
+			[]param{placeholder, base, bitSize, minLen, maxLen},
+		},
 	}
 )
 
@@ -56,11 +135,33 @@ func (o param) Global() bool {
 	return o.flags&global > 0
 }
 
+func (o param) Value() bool {
+	return o.flags&valueParam > 0
+}
+
+// FieldType is the type of the param in the parser; values are pointers so that zero values may be distinguished.
+func (o param) FieldType() string {
+	if o.Value() {
+		return "*" + o.Type
+	}
+	return o.Type
+}
+
+// DescriptionType is the type of the param in the description; values are formatted as they'd be written in docOpts.
+func (o param) DescriptionType() string {
+	if o.Value() {
+		return "string"
+	}
+	return o.Type
+}
+
 type specCfg struct {
 	MethodName, TypeName string
 	ParseFunc            string
-	imports              []string
-	options              []param
+	// FormatExpr formats an element v of the type back into the syntax accepted by ParseFunc.
+	FormatExpr string
+	imports    []string
+	options    []param
 }
 
 func (s specCfg) ParserName() string {
@@ -73,12 +174,23 @@ func (s specCfg) OptName() string {
 
 func (s specCfg) allOptions() []param {
 	options := append(make([]param, 0, len(s.options)), s.options...) // copy errytime
-	if s.Slice() {
+	for i, o := range options {
+		if o.Value() {
+			options[i].Type = s.TypeName
+		}
+	}
+	if s.Slice() || s.Map() {
 		options = append(options, comma)
 	}
+	if s.Map() {
+		options = append(options, keyValueSep)
+	}
 	options = append(options,
 		param{"Default", s.TypeName, "", "", "", "specifies a default value", 0},
 		optional,
+		secret,
+		fileOK,
+		reload,
 	)
 	return options
 }
@@ -107,6 +219,25 @@ func (s specCfg) Fields() []param {
 	}, true)
 }
 
+func (s specCfg) ValueParams() []param {
+	return s.filterOpts(param.Value, true)
+}
+
+func (s specCfg) Validated() bool {
+	return len(s.filterOpts(func(o param) bool {
+		return o.flags&validation > 0
+	}, false)) > 0
+}
+
+func (s specCfg) HasParam(name string) bool {
+	for _, o := range s.allOptions() {
+		if o.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (s specCfg) filterOpts(f func(o param) bool, doSort bool) (opts []param) {
 	for _, o := range s.allOptions() {
 		if f(o) {
@@ -134,6 +265,25 @@ func (s specCfg) Slice() bool {
 	return strings.Contains(strings.ToLower(s.MethodName), "slice")
 }
 
+func (s specCfg) Map() bool {
+	return strings.HasPrefix(s.TypeName, "map[")
+}
+
+// TypeImports returns the packages needed to refer to the spec's type outside of its generated file.
+func (s specCfg) TypeImports() []string {
+	var imports []string
+	for _, pkg := range [...][2]string{
+		{"net.", "net"},
+		{"time.", "time"},
+		{"url.", "net/url"},
+	} {
+		if strings.Contains(s.TypeName, pkg[0]) {
+			imports = append(imports, pkg[1])
+		}
+	}
+	return imports
+}
+
 func (s specCfg) Imports() []string {
 	var (
 		imports = s.imports
@@ -145,9 +295,6 @@ func (s specCfg) Imports() []string {
 			seen[s] = true
 		}
 	}
-	if s.Slice() && s.ParseFunc != "" {
-		push("fmt")
-	}
 	if s.CustomJSON() {
 		push("encoding/json")
 	}
@@ -157,14 +304,18 @@ func (s specCfg) Imports() []string {
 		}
 	}
 	push("strings")
-	push("errors")
+	for _, o := range s.LocalOptions() {
+		if o.Type == "rune" {
+			push("errors")
+		}
+	}
 	sort.Strings(imports)
 	return imports
 }
 
 func main() {
-	if len(os.Args) != 3 {
-		log.Fatal("usage: SPEC_TEMPLATE UNI_OPT_TEMPLATE")
+	if len(os.Args) != 4 {
+		log.Fatal("usage: SPEC_TEMPLATE UNI_OPT_TEMPLATE LOAD_TEMPLATE")
 	}
 	tmpl, err := fileTmplWithFuncs(os.Args[1])
 	if err != nil {
@@ -175,10 +326,19 @@ func main() {
 		log.Fatal(err)
 	}
 
+	loadTmpl, err := fileTmplWithFuncs(os.Args[3])
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	if err := executeTmpl(uniOptTmpl, "uni_opt.gen.go", types); err != nil {
 		log.Fatal(err)
 	}
 
+	if err := executeTmpl(loadTmpl, "load.gen.go", loadData(types)); err != nil {
+		log.Fatal(err)
+	}
+
 	for _, s := range types {
 		if err := executeTmpl(tmpl, snakeCase(s.MethodName)+".gen.go", s); err != nil {
 			log.Fatalf("%v: %v", snakeCase(s.MethodName)+".gen.go", err)
@@ -186,6 +346,30 @@ func main() {
 	}
 }
 
+type loadCfg struct {
+	Imports []string
+	Types   []specCfg
+}
+
+func loadData(types []specCfg) loadCfg {
+	var (
+		data loadCfg
+		seen = make(map[string]bool)
+	)
+	for _, t := range types {
+		for _, i := range t.TypeImports() {
+			if !seen[i] {
+				data.Imports = append(data.Imports, i)
+				seen[i] = true
+			}
+		}
+		data.Types = append(data.Types, t)
+	}
+	data.Imports = append(data.Imports, "reflect")
+	sort.Strings(data.Imports)
+	return data
+}
+
 func executeTmpl(tmpl *template.Template, filename string, data interface{}) error {
 	var b bytes.Buffer
 	if err := tmpl.Execute(&b, data); err != nil {
@@ -243,6 +427,9 @@ func normalize(s string) string {
 	for _, r := range [...][2]string{
 		{"URL", "Url"},
 		{"IP", "Ip"},
+		{"IEC", "Iec"},
+		{"OK", "Ok"},
+		{"OneOf", "Oneof"},
 	} {
 		s = strings.ReplaceAll(s, r[0], r[1])
 	}
@@ -3,8 +3,8 @@ package envcfg
 // Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
 
 import (
-	"errors"
 	"net"
+	"strconv"
 	"strings"
 )
 
@@ -16,18 +16,19 @@ import (
 //
 // Available options:
 // 		- "default" or IPDefault
+// 		- "file_ok" or FileOK
+// 		- "ipv4" or IPIPv4
+// 		- "ipv6" or IPIPv6
 // 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 func (c *Cfg) IP(docOpts string, opts ...IPOpt) (v net.IP) {
 	s, err := newIPSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).(net.IP)
+	v, _ = c.register(s).(net.IP)
 	return
 }
 
@@ -42,6 +43,20 @@ func IPDefault(def net.IP) IPOpt {
 	return defaultOpt(def)
 }
 
+// IPIPv4 restricts values to IPv4 addresses for a IP variable.
+func IPIPv4(ipv4 bool) IPOpt {
+	return ipOptFunc(func(p *ipParser) {
+		p.ipv4 = ipv4
+	})
+}
+
+// IPIPv6 restricts values to IPv6 addresses for a IP variable.
+func IPIPv6(ipv6 bool) IPOpt {
+	return ipOptFunc(func(p *ipParser) {
+		p.ipv6 = ipv6
+	})
+}
+
 type ipOptFunc func(p *ipParser)
 
 func (f ipOptFunc) modifyIPParser(p *ipParser) {
@@ -55,7 +70,7 @@ var _ IPOpt = new(ipOptFunc)
 func newIPSpec(docOpts string, opts []IPOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(ipParser)
@@ -71,24 +86,27 @@ func newIPSpec(docOpts string, opts []IPOpt) (*spec, error) {
 			opt IPOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
-		case "optional":
+		switch key := strings.ToLower(f[0]); key {
+		case "ipv4":
+			val := true // a bare flag is set
+			if f[1] != "" {
+				val, err = strconv.ParseBool(f[1])
+			}
+			opt = IPIPv4(val)
+		case "ipv6":
+			val := true // a bare flag is set
 			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
+				val, err = strconv.ParseBool(f[1])
 			}
-			opt = Optional
+			opt = IPIPv6(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyIPParser(p)
@@ -101,7 +119,10 @@ func newIPSpec(docOpts string, opts []IPOpt) (*spec, error) {
 
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -109,14 +130,31 @@ func newIPSpec(docOpts string, opts []IPOpt) (*spec, error) {
 }
 
 type ipParser struct {
+	ipv4 bool
+	ipv6 bool
 }
 
 func (p *ipParser) parse(s string) (interface{}, error) {
 	return parseIP(
 		s,
+		p.ipv4,
+		p.ipv6,
 	)
 }
 
+func (p *ipParser) format(val interface{}) string {
+	v, _ := val.(net.IP)
+	return v.String()
+}
+
 func (p *ipParser) describe() interface{} {
-	return struct{}{}
+	return ipParserDescription{
+		IPv4: p.ipv4,
+		IPv6: p.ipv6,
+	}
+}
+
+type ipParserDescription struct {
+	IPv4 bool `json:"ipv4,omitempty"`
+	IPv6 bool `json:"ipv6,omitempty"`
 }
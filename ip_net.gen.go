@@ -0,0 +1,160 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// IPNet extracts and parses a *net.IPNet variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe IPNetOpts.
+//
+// Available options:
+// 		- "default" or IPNetDefault
+// 		- "file_ok" or FileOK
+// 		- "ipv4" or IPNetIPv4
+// 		- "ipv6" or IPNetIPv6
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) IPNet(docOpts string, opts ...IPNetOpt) (v *net.IPNet) {
+	s, err := newIPNetSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).(*net.IPNet)
+	return
+}
+
+// IPNetOpt modifies IPNet variable configuration.
+type IPNetOpt interface {
+	modify(s *spec)
+	modifyIPNetParser(p *ipNetParser)
+}
+
+// IPNetDefault specifies a default value for a IPNet variable.
+func IPNetDefault(def *net.IPNet) IPNetOpt {
+	return defaultOpt(def)
+}
+
+// IPNetIPv4 restricts values to IPv4 addresses for a IPNet variable.
+func IPNetIPv4(ipv4 bool) IPNetOpt {
+	return ipNetOptFunc(func(p *ipNetParser) {
+		p.ipv4 = ipv4
+	})
+}
+
+// IPNetIPv6 restricts values to IPv6 addresses for a IPNet variable.
+func IPNetIPv6(ipv6 bool) IPNetOpt {
+	return ipNetOptFunc(func(p *ipNetParser) {
+		p.ipv6 = ipv6
+	})
+}
+
+type ipNetOptFunc func(p *ipNetParser)
+
+func (f ipNetOptFunc) modifyIPNetParser(p *ipNetParser) {
+	f(p)
+}
+
+func (ipNetOptFunc) modify(*spec) {}
+
+var _ IPNetOpt = new(ipNetOptFunc)
+
+func newIPNetSpec(docOpts string, opts []IPNetOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(ipNetParser)
+	s := &spec{
+		parser:   p,
+		typeName: "*net.IPNet",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt IPNetOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "ipv4":
+			val := true // a bare flag is set
+			if f[1] != "" {
+				val, err = strconv.ParseBool(f[1])
+			}
+			opt = IPNetIPv4(val)
+		case "ipv6":
+			val := true // a bare flag is set
+			if f[1] != "" {
+				val, err = strconv.ParseBool(f[1])
+			}
+			opt = IPNetIPv6(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyIPNetParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyIPNetParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type ipNetParser struct {
+	ipv4 bool
+	ipv6 bool
+}
+
+func (p *ipNetParser) parse(s string) (interface{}, error) {
+	return parseIPNet(
+		s,
+		p.ipv4,
+		p.ipv6,
+	)
+}
+
+func (p *ipNetParser) format(val interface{}) string {
+	v, _ := val.(*net.IPNet)
+	return v.String()
+}
+
+func (p *ipNetParser) describe() interface{} {
+	return ipNetParserDescription{
+		IPv4: p.ipv4,
+		IPv6: p.ipv6,
+	}
+}
+
+type ipNetParserDescription struct {
+	IPv4 bool `json:"ipv4,omitempty"`
+	IPv6 bool `json:"ipv6,omitempty"`
+}
@@ -0,0 +1,261 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// IPNetSlice extracts and parses a []*net.IPNet variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe IPNetSliceOpts.
+//
+// Available options:
+// 		- "comma" or IPNetSliceComma
+// 		- "default" or IPNetSliceDefault
+// 		- "file_ok" or FileOK
+// 		- "ipv4" or IPNetSliceIPv4
+// 		- "ipv6" or IPNetSliceIPv6
+// 		- "max_len" or IPNetSliceMaxLen
+// 		- "min_len" or IPNetSliceMinLen
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) IPNetSlice(docOpts string, opts ...IPNetSliceOpt) (v []*net.IPNet) {
+	s, err := newIPNetSliceSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).([]*net.IPNet)
+	return
+}
+
+// IPNetSliceOpt modifies IPNetSlice variable configuration.
+type IPNetSliceOpt interface {
+	modify(s *spec)
+	modifyIPNetSliceParser(p *ipNetSliceParser)
+}
+
+// IPNetSliceComma specifies the comma to use for a IPNetSlice variable.
+func IPNetSliceComma(comma rune) IPNetSliceOpt {
+	return ipNetSliceOptFunc(func(p *ipNetSliceParser) {
+		p.comma = comma
+	})
+}
+
+// IPNetSliceDefault specifies a default value for a IPNetSlice variable.
+func IPNetSliceDefault(def []*net.IPNet) IPNetSliceOpt {
+	return defaultOpt(def)
+}
+
+// IPNetSliceIPv4 restricts values to IPv4 addresses for a IPNetSlice variable.
+func IPNetSliceIPv4(ipv4 bool) IPNetSliceOpt {
+	return ipNetSliceOptFunc(func(p *ipNetSliceParser) {
+		p.ipv4 = ipv4
+	})
+}
+
+// IPNetSliceIPv6 restricts values to IPv6 addresses for a IPNetSlice variable.
+func IPNetSliceIPv6(ipv6 bool) IPNetSliceOpt {
+	return ipNetSliceOptFunc(func(p *ipNetSliceParser) {
+		p.ipv6 = ipv6
+	})
+}
+
+// IPNetSliceMaxLen specifies the maximum permitted length for a IPNetSlice variable.
+func IPNetSliceMaxLen(maxLen int) IPNetSliceOpt {
+	return ipNetSliceOptFunc(func(p *ipNetSliceParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// IPNetSliceMinLen specifies the minimum permitted length for a IPNetSlice variable.
+func IPNetSliceMinLen(minLen int) IPNetSliceOpt {
+	return ipNetSliceOptFunc(func(p *ipNetSliceParser) {
+		p.minLen = minLen
+	})
+}
+
+type ipNetSliceOptFunc func(p *ipNetSliceParser)
+
+func (f ipNetSliceOptFunc) modifyIPNetSliceParser(p *ipNetSliceParser) {
+	f(p)
+}
+
+func (ipNetSliceOptFunc) modify(*spec) {}
+
+var _ IPNetSliceOpt = new(ipNetSliceOptFunc)
+
+func newIPNetSliceSpec(docOpts string, opts []IPNetSliceOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(ipNetSliceParser)
+	s := &spec{
+		parser:   p,
+		typeName: "[]*net.IPNet",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt IPNetSliceOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "comma":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = IPNetSliceComma(value[0])
+		case "ipv4":
+			val := true // a bare flag is set
+			if f[1] != "" {
+				val, err = strconv.ParseBool(f[1])
+			}
+			opt = IPNetSliceIPv4(val)
+		case "ipv6":
+			val := true // a bare flag is set
+			if f[1] != "" {
+				val, err = strconv.ParseBool(f[1])
+			}
+			opt = IPNetSliceIPv6(val)
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = IPNetSliceMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = IPNetSliceMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyIPNetSliceParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyIPNetSliceParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type ipNetSliceParser struct {
+	comma  rune
+	ipv4   bool
+	ipv6   bool
+	maxLen int
+	minLen int
+}
+
+func (p *ipNetSliceParser) parse(s string) (interface{}, error) {
+	ses, err := parseSlice(s, p.comma)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make([]*net.IPNet, len(ses))
+	for i, v := range ses {
+		el, err := parseIPNet(
+			v,
+			p.ipv4,
+			p.ipv6,
+		)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		vals[i] = el
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+
+}
+
+func (p *ipNetSliceParser) validate(v []*net.IPNet) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *ipNetSliceParser) format(val interface{}) string {
+	vals, _ := val.([]*net.IPNet)
+	ses := make([]string, len(vals))
+	for i, v := range vals {
+		ses[i] = v.String()
+	}
+	return formatSlice(ses, p.comma)
+}
+
+func (p *ipNetSliceParser) describe() interface{} {
+	return ipNetSliceParserDescription{
+		Comma:  p.comma,
+		IPv4:   p.ipv4,
+		IPv6:   p.ipv6,
+		MaxLen: p.maxLen,
+		MinLen: p.minLen,
+	}
+}
+
+type ipNetSliceParserDescription struct {
+	Comma  rune `json:"comma,omitempty"`
+	IPv4   bool `json:"ipv4,omitempty"`
+	IPv6   bool `json:"ipv6,omitempty"`
+	MaxLen int  `json:"max_len,omitempty"`
+	MinLen int  `json:"min_len,omitempty"`
+}
+
+func (d ipNetSliceParserDescription) MarshalJSON() ([]byte, error) {
+	var comma string
+	if d.Comma != 0 {
+		comma = string(d.Comma)
+	}
+	return json.Marshal(struct {
+		Comma  string `json:"comma,omitempty"`
+		IPv4   bool   `json:"ipv4,omitempty"`
+		IPv6   bool   `json:"ipv6,omitempty"`
+		MaxLen int    `json:"max_len,omitempty"`
+		MinLen int    `json:"min_len,omitempty"`
+	}{
+		Comma:  comma,
+		IPv4:   d.IPv4,
+		IPv6:   d.IPv6,
+		MaxLen: d.MaxLen,
+		MinLen: d.MinLen,
+	})
+}
@@ -0,0 +1,261 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// IPSlice extracts and parses a []net.IP variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe IPSliceOpts.
+//
+// Available options:
+// 		- "comma" or IPSliceComma
+// 		- "default" or IPSliceDefault
+// 		- "file_ok" or FileOK
+// 		- "ipv4" or IPSliceIPv4
+// 		- "ipv6" or IPSliceIPv6
+// 		- "max_len" or IPSliceMaxLen
+// 		- "min_len" or IPSliceMinLen
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) IPSlice(docOpts string, opts ...IPSliceOpt) (v []net.IP) {
+	s, err := newIPSliceSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).([]net.IP)
+	return
+}
+
+// IPSliceOpt modifies IPSlice variable configuration.
+type IPSliceOpt interface {
+	modify(s *spec)
+	modifyIPSliceParser(p *ipSliceParser)
+}
+
+// IPSliceComma specifies the comma to use for a IPSlice variable.
+func IPSliceComma(comma rune) IPSliceOpt {
+	return ipSliceOptFunc(func(p *ipSliceParser) {
+		p.comma = comma
+	})
+}
+
+// IPSliceDefault specifies a default value for a IPSlice variable.
+func IPSliceDefault(def []net.IP) IPSliceOpt {
+	return defaultOpt(def)
+}
+
+// IPSliceIPv4 restricts values to IPv4 addresses for a IPSlice variable.
+func IPSliceIPv4(ipv4 bool) IPSliceOpt {
+	return ipSliceOptFunc(func(p *ipSliceParser) {
+		p.ipv4 = ipv4
+	})
+}
+
+// IPSliceIPv6 restricts values to IPv6 addresses for a IPSlice variable.
+func IPSliceIPv6(ipv6 bool) IPSliceOpt {
+	return ipSliceOptFunc(func(p *ipSliceParser) {
+		p.ipv6 = ipv6
+	})
+}
+
+// IPSliceMaxLen specifies the maximum permitted length for a IPSlice variable.
+func IPSliceMaxLen(maxLen int) IPSliceOpt {
+	return ipSliceOptFunc(func(p *ipSliceParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// IPSliceMinLen specifies the minimum permitted length for a IPSlice variable.
+func IPSliceMinLen(minLen int) IPSliceOpt {
+	return ipSliceOptFunc(func(p *ipSliceParser) {
+		p.minLen = minLen
+	})
+}
+
+type ipSliceOptFunc func(p *ipSliceParser)
+
+func (f ipSliceOptFunc) modifyIPSliceParser(p *ipSliceParser) {
+	f(p)
+}
+
+func (ipSliceOptFunc) modify(*spec) {}
+
+var _ IPSliceOpt = new(ipSliceOptFunc)
+
+func newIPSliceSpec(docOpts string, opts []IPSliceOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(ipSliceParser)
+	s := &spec{
+		parser:   p,
+		typeName: "[]net.IP",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt IPSliceOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "comma":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = IPSliceComma(value[0])
+		case "ipv4":
+			val := true // a bare flag is set
+			if f[1] != "" {
+				val, err = strconv.ParseBool(f[1])
+			}
+			opt = IPSliceIPv4(val)
+		case "ipv6":
+			val := true // a bare flag is set
+			if f[1] != "" {
+				val, err = strconv.ParseBool(f[1])
+			}
+			opt = IPSliceIPv6(val)
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = IPSliceMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = IPSliceMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyIPSliceParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyIPSliceParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type ipSliceParser struct {
+	comma  rune
+	ipv4   bool
+	ipv6   bool
+	maxLen int
+	minLen int
+}
+
+func (p *ipSliceParser) parse(s string) (interface{}, error) {
+	ses, err := parseSlice(s, p.comma)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make([]net.IP, len(ses))
+	for i, v := range ses {
+		el, err := parseIP(
+			v,
+			p.ipv4,
+			p.ipv6,
+		)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		vals[i] = el
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+
+}
+
+func (p *ipSliceParser) validate(v []net.IP) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *ipSliceParser) format(val interface{}) string {
+	vals, _ := val.([]net.IP)
+	ses := make([]string, len(vals))
+	for i, v := range vals {
+		ses[i] = v.String()
+	}
+	return formatSlice(ses, p.comma)
+}
+
+func (p *ipSliceParser) describe() interface{} {
+	return ipSliceParserDescription{
+		Comma:  p.comma,
+		IPv4:   p.ipv4,
+		IPv6:   p.ipv6,
+		MaxLen: p.maxLen,
+		MinLen: p.minLen,
+	}
+}
+
+type ipSliceParserDescription struct {
+	Comma  rune `json:"comma,omitempty"`
+	IPv4   bool `json:"ipv4,omitempty"`
+	IPv6   bool `json:"ipv6,omitempty"`
+	MaxLen int  `json:"max_len,omitempty"`
+	MinLen int  `json:"min_len,omitempty"`
+}
+
+func (d ipSliceParserDescription) MarshalJSON() ([]byte, error) {
+	var comma string
+	if d.Comma != 0 {
+		comma = string(d.Comma)
+	}
+	return json.Marshal(struct {
+		Comma  string `json:"comma,omitempty"`
+		IPv4   bool   `json:"ipv4,omitempty"`
+		IPv6   bool   `json:"ipv6,omitempty"`
+		MaxLen int    `json:"max_len,omitempty"`
+		MinLen int    `json:"min_len,omitempty"`
+	}{
+		Comma:  comma,
+		IPv4:   d.IPv4,
+		IPv6:   d.IPv6,
+		MaxLen: d.MaxLen,
+		MinLen: d.MinLen,
+	})
+}
@@ -0,0 +1,106 @@
+package envcfg
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Load populates the struct pointed to by ptr from the environment.
+//
+// Every field with an `env` tag is extracted with the Cfg method matching its type (e.g. int64 fields use Int and
+// []string fields use StringSlice); the tag itself is passed through as that method's docOpts, so
+//
+//	Timeout time.Duration `env:"TIMEOUT default=5s | How long to wait"`
+//
+// is equivalent to assigning cfg.Duration("TIMEOUT default=5s | How long to wait") and produces the same Description.
+// Fields of other bool, string, integer or float types -- e.g. int, uint16, float32 or a named string type -- are
+// extracted with Bool, String, Int, Uint or Float, with a bit size matching the field's, and converted to the field's
+// type. Named int64 and uint64 types are unsupported, though, since they can't be told apart from types defined as
+// time.Duration or ByteSize. Fields tagged "-" are skipped, as are untagged fields, except for untagged struct fields, which are loaded
+// recursively.
+func (c *Cfg) Load(ptr interface{}) {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		c.addError(fmt.Errorf("Load requires a non-nil pointer to a struct, got %T", ptr))
+		return
+	}
+	c.load(v.Elem())
+}
+
+func (c *Cfg) load(v reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		docOpts, tagged := f.Tag.Lookup("env")
+		if docOpts == "-" {
+			continue
+		}
+
+		loader, ok := loaders[f.Type]
+		if !ok {
+			loader, ok = kindLoader(f.Type)
+		}
+		if !tagged {
+			if !ok && f.Type.Kind() == reflect.Struct && (f.Anonymous || v.Field(i).CanSet()) {
+				c.load(v.Field(i))
+			}
+			continue
+		}
+
+		if !v.Field(i).CanSet() {
+			c.addError(fmt.Errorf("%v.%v: cannot load unexported field", t, f.Name))
+			continue
+		}
+		if !ok {
+			c.addError(fmt.Errorf("%v.%v: unsupported type %v", t, f.Name, f.Type))
+			continue
+		}
+		v.Field(i).Set(reflect.ValueOf(loader(c, docOpts)).Convert(f.Type))
+	}
+}
+
+// kindLoader returns a loader for types without one of their own, using the method for the 64-bit type of the same
+// kind; the value it returns must be converted to the type. Named types of the same kind as a named type with a loader
+// -- e.g. a type defined as time.Duration, whose underlying type is int64 -- are rejected, since they can't be told
+// apart and would be misparsed.
+func kindLoader(t reflect.Type) (func(c *Cfg, docOpts string) interface{}, bool) {
+	if t.PkgPath() != "" {
+		for lt := range loaders {
+			if lt.PkgPath() != "" && lt.Kind() == t.Kind() && t.ConvertibleTo(lt) {
+				return nil, false
+			}
+		}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return loaders[reflect.TypeOf(false)], true
+	case reflect.String:
+		return loaders[reflect.TypeOf("")], true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var opts []IntOpt
+		if t.Bits() < 64 {
+			opts = append(opts, IntBitSize(t.Bits()))
+		}
+		return func(c *Cfg, docOpts string) interface{} {
+			return c.Int(docOpts, opts...)
+		}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var opts []UintOpt
+		if t.Bits() < 64 {
+			opts = append(opts, UintBitSize(t.Bits()))
+		}
+		return func(c *Cfg, docOpts string) interface{} {
+			return c.Uint(docOpts, opts...)
+		}, true
+	case reflect.Float32, reflect.Float64:
+		var opts []FloatOpt
+		if t.Bits() < 64 {
+			opts = append(opts, FloatBitSize(t.Bits()))
+		}
+		return func(c *Cfg, docOpts string) interface{} {
+			return c.Float(docOpts, opts...)
+		}, true
+	}
+	return nil, false
+}
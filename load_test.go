@@ -0,0 +1,146 @@
+package envcfg_test
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jwilner/envcfg"
+)
+
+type (
+	logLevel string
+	timeout  time.Duration
+)
+
+func TestCfg_Load(t *testing.T) {
+	type nested struct {
+		Hosts []string `env:"HOSTS comma=; | Hosts to contact"`
+	}
+	type config struct {
+		Timeout  time.Duration `env:"TIMEOUT default=5s | How long to wait"`
+		Port     int64         `env:"PORT"`
+		Ratio    float64       `env:"RATIO bit_size=32 optional"`
+		Ints     []int64       `env:"INTS base=16 comma=:"`
+		Addr     net.IP        `env:"ADDR default=127.0.0.1"`
+		Debug    bool          `env:"DEBUG default=false"`
+		Workers  int           `env:"WORKERS default=4"`
+		Shard    uint16        `env:"SHARD"`
+		Scale    float32       `env:"SCALE default=0.5"`
+		Level    logLevel      `env:"LEVEL default=info"`
+		Ignored  string        `env:"-"`
+		Untagged string
+		Nested   nested
+	}
+
+	env := map[string]string{
+		"PORT":  "8080",
+		"INTS":  "a:b:c",
+		"HOSTS": "a.com;b.com",
+		"DEBUG": "true",
+		"SHARD": "7",
+	}
+	newCfg := func() *envcfg.Cfg {
+		return envcfg.New(
+			envcfg.Panic(false),
+			envcfg.EnvFunc(func(k string) (string, bool) {
+				v, ok := env[k]
+				return v, ok
+			}),
+		)
+	}
+
+	c := newCfg()
+	var got config
+	c.Load(&got)
+	descriptions, err := c.Result()
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+
+	want := config{
+		Timeout: 5 * time.Second,
+		Port:    8080,
+		Ints:    []int64{10, 11, 12},
+		Addr:    net.ParseIP("127.0.0.1"),
+		Debug:   true,
+		Workers: 4,
+		Shard:   7,
+		Scale:   0.5,
+		Level:   "info",
+		Nested:  nested{Hosts: []string{"a.com", "b.com"}},
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Load() want = %+v, got %+v", want, got)
+	}
+
+	expected := newCfg()
+	_ = expected.Duration("TIMEOUT default=5s | How long to wait")
+	_ = expected.Int("PORT")
+	_ = expected.Float("RATIO bit_size=32 optional")
+	_ = expected.IntSlice("INTS base=16 comma=:")
+	_ = expected.IP("ADDR default=127.0.0.1")
+	_ = expected.Bool("DEBUG default=false")
+	_ = expected.Int("WORKERS default=4")
+	_ = expected.Uint("SHARD", envcfg.UintBitSize(16))
+	_ = expected.Float("SCALE default=0.5", envcfg.FloatBitSize(32))
+	_ = expected.String("LEVEL default=info")
+	_ = expected.StringSlice("HOSTS comma=; | Hosts to contact")
+	if !reflect.DeepEqual(expected.Describe(), descriptions) {
+		t.Errorf("Load() descriptions = %+v, want %+v", descriptions, expected.Describe())
+	}
+}
+
+func TestCfg_Load_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		ptr     interface{}
+		wantErr string
+	}{
+		{name: "not a pointer", ptr: struct{}{}, wantErr: "Load requires a non-nil pointer to a struct, got struct {}"},
+		{name: "not a struct", ptr: new(int), wantErr: "Load requires a non-nil pointer to a struct, got *int"},
+		{
+			name: "unsupported type",
+			ptr: &struct {
+				A complex128 `env:"A"`
+			}{},
+			wantErr: "struct { A complex128 \"env:\\\"A\\\"\" }.A: unsupported type complex128",
+		},
+		{
+			name: "ambiguous named type",
+			ptr: &struct {
+				T timeout `env:"T default=5s"`
+			}{},
+			wantErr: "struct { T envcfg_test.timeout \"env:\\\"T default=5s\\\"\" }.T: unsupported type envcfg_test.timeout",
+		},
+		{
+			name: "out of range for field",
+			ptr: &struct {
+				A int8 `env:"A default=300"`
+			}{},
+			wantErr: `A: invalid option "default": strconv.ParseInt: parsing "300": value out of range`,
+		},
+		{
+			name: "unexported",
+			ptr: &struct {
+				a string `env:"A"`
+			}{},
+			wantErr: "struct { a string \"env:\\\"A\\\"\" }.a: cannot load unexported field",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := envcfg.New(envcfg.Panic(false))
+			c.Load(tt.ptr)
+
+			var errS string
+			if err := c.Err(); err != nil {
+				errS = err.Error()
+			}
+			if errS != tt.wantErr {
+				t.Errorf("Load() error = %q, wantErr %q", errS, tt.wantErr)
+			}
+		})
+	}
+}
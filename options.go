@@ -1,13 +1,25 @@
 package envcfg
 
+import "errors"
+
 type Option func(c *Cfg)
 
 func EnvFunc(envFunc func(string) (string, bool)) Option {
+	return Sources(Source{Name: provenanceEnv, Lookup: envFunc})
+}
+
+// Strict reports an UndeclaredError from Err for every variable beginning with the prefix which is set in a Source
+// supporting List -- e.g. ProcessEnv, but not EnvFunc -- but which was not extracted, catching misspelled overrides. If
+// no source supports List, Err reports ErrNotListable instead. Since such errors can only be known once every variable
+// has been declared, they're never panicked.
+func Strict(prefix string) Option {
 	return func(c *Cfg) {
-		c.envFunc = envFunc
+		c.strict = append(c.strict, prefix)
 	}
 }
 
+// Panic makes the Cfg panic on the first error rather than collecting every error to be reported at once by Err. It's
+// disabled by default.
 func Panic(b bool) Option {
 	return func(g *Cfg) {
 		g.panic = b
@@ -21,19 +33,47 @@ func ErrMaker(f func(errs []error) error) Option {
 }
 
 func defaultErrMaker(errs []error) error {
-	return errs[0]
+	return append(Errors(nil), errs...)
 }
 
 const (
 	flagOptional = 1 << iota
 	flagDefaultVal
 	flagDefaultValString
+	flagSecret
+	flagFileOK
+	flagReloadable
 )
 
 var Optional UniOpt = uniOptFunc(func(s *spec) {
 	s.flags |= flagOptional
 })
 
+// Secret marks the variable as sensitive: its Description omits its default and sets Sensitive, and its errors don't
+// include its value.
+var Secret UniOpt = uniOptFunc(func(s *spec) {
+	s.flags |= flagSecret
+})
+
+// FileOK allows the variable to instead be read from the file named by the variable suffixed with _FILE, e.g.
+// DB_PASSWORD_FILE=/run/secrets/db, as is conventional for secrets mounted into containers. Each source is checked for
+// both in turn, so the variable's file takes precedence over the variable itself in a later source.
+var FileOK UniOpt = uniOptFunc(func(s *spec) {
+	s.flags |= flagFileOK
+})
+
+// Reloadable marks the variable as able to change while the process runs; see Cfg.Watch.
+var Reloadable UniOpt = uniOptFunc(func(s *spec) {
+	s.flags |= flagReloadable
+})
+
+// FileIndirection applies FileOK to every variable.
+func FileIndirection(b bool) Option {
+	return func(g *Cfg) {
+		g.fileOK = b
+	}
+}
+
 func defaultOpt(defVal interface{}) uniOptFunc {
 	return func(s *spec) {
 		s.defaultVal = defVal
@@ -46,3 +86,35 @@ func Comment(comment string) UniOpt {
 		s.comment = comment
 	})
 }
+
+// parseUniOpt returns the UniOpt corresponding to a docOpts field, or nil if the key is not a universal option.
+func parseUniOpt(key, val string) (UniOpt, error) {
+	switch key {
+	case "default":
+		return uniOptFunc(func(s *spec) {
+			s.flags |= flagDefaultValString | flagDefaultVal
+			s.defaultValS = val
+		}), nil
+	case "optional":
+		if val != "" {
+			return nil, errors.New("optional does not take any arguments")
+		}
+		return Optional, nil
+	case "secret":
+		if val != "" {
+			return nil, errors.New("secret does not take any arguments")
+		}
+		return Secret, nil
+	case "file_ok":
+		if val != "" {
+			return nil, errors.New("file_ok does not take any arguments")
+		}
+		return FileOK, nil
+	case "reloadable":
+		if val != "" {
+			return nil, errors.New("reloadable does not take any arguments")
+		}
+		return Reloadable, nil
+	}
+	return nil, nil
+}
@@ -3,13 +3,19 @@ package envcfg
 import (
 	"encoding/base64"
 	"encoding/csv"
-	"errors"
 	"net"
+	"net/url"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 type parser interface {
 	parse(s string) (interface{}, error)
+	// format is the inverse of parse, returning a string which parses to the value provided.
+	format(v interface{}) string
 	describe() interface{}
 }
 
@@ -28,11 +34,133 @@ func parseSlice(v string, comma rune) ([]string, error) {
 	case 1:
 		return res[0], nil
 	default:
-		return nil, errors.New("at most one line is supported")
+		return nil, valueError("at most one line is supported")
 	}
 }
 
+// parsePairs parses a list of key-value pairs, e.g. "a=1,b=2"; sep separates keys from values and defaults to '='.
+func parsePairs(v string, comma, sep rune) ([][2]string, error) {
+	if sep == 0 {
+		sep = '='
+	}
+	ses, err := parseSlice(v, comma)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		pairs = make([][2]string, len(ses))
+		seen  = make(map[string]bool, len(ses))
+	)
+	for i, s := range ses {
+		idx := strings.IndexRune(s, sep)
+		if idx < 0 {
+			return nil, indexError(i, valueErrorf("missing separator %q", sep))
+		}
+		k := s[:idx]
+		if seen[k] {
+			return nil, indexError(i, valueErrorf("duplicate key %q", k))
+		}
+		seen[k] = true
+		pairs[i] = [2]string{k, s[idx+utf8.RuneLen(sep):]}
+	}
+	return pairs, nil
+}
+
+// formatPairs is the inverse of parsePairs, ordering the pairs by key.
+func formatPairs(m map[string]string, comma, sep rune) string {
+	if sep == 0 {
+		sep = '='
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	ses := make([]string, len(keys))
+	for i, k := range keys {
+		ses[i] = k + string(sep) + m[k]
+	}
+	return formatSlice(ses, comma)
+}
+
+func containsString(ses []string, s string) bool {
+	for _, el := range ses {
+		if el == s {
+			return true
+		}
+	}
+	return false
+}
+
+func parseURL(s string, schemes []string, requireHost bool) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(schemes) > 0 && !containsString(schemes, u.Scheme) {
+		return nil, valueErrorf("scheme must be one of %v", strings.Join(schemes, ","))
+	}
+	if requireHost && u.Host == "" {
+		return nil, valueError("host is required")
+	}
+	return u, nil
+}
+
+func formatURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	return u.String()
+}
+
+func formatSlice(ses []string, comma rune) string {
+	var b strings.Builder
+	w := csv.NewWriter(&b)
+	if comma != 0 {
+		w.Comma = comma
+	}
+	_ = w.Write(ses)
+	w.Flush()
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func formatInt(v int64, base int) string {
+	if base == 0 {
+		base = 10
+	}
+	return strconv.FormatInt(v, base)
+}
+
+func formatUint(v uint64, base int) string {
+	if base == 0 {
+		base = 10
+	}
+	return strconv.FormatUint(v, base)
+}
+
+func formatFloat(v float64, bitSize int) string {
+	if bitSize == 0 {
+		bitSize = 64
+	}
+	return strconv.FormatFloat(v, 'g', -1, bitSize)
+}
+
+func formatTime(v time.Time, layout string) string {
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	return v.Format(layout)
+}
+
 func parseBytes(s string, padding rune, noPadding, urlSafe bool) ([]byte, error) {
+	return base64Encoding(padding, noPadding, urlSafe).DecodeString(s)
+}
+
+func formatBytes(b []byte, padding rune, noPadding, urlSafe bool) string {
+	return base64Encoding(padding, noPadding, urlSafe).EncodeToString(b)
+}
+
+func base64Encoding(padding rune, noPadding, urlSafe bool) *base64.Encoding {
 	enc := base64.StdEncoding
 	if urlSafe {
 		enc = base64.URLEncoding
@@ -43,13 +171,38 @@ func parseBytes(s string, padding rune, noPadding, urlSafe bool) ([]byte, error)
 	if noPadding {
 		enc = enc.WithPadding(base64.NoPadding)
 	}
-	return enc.DecodeString(s)
+	return enc
 }
 
-func parseIP(s string) (net.IP, error) {
+func parseIP(s string, ipv4, ipv6 bool) (net.IP, error) {
 	parsed := net.ParseIP(s)
 	if parsed == nil {
-		return nil, errors.New("invalid IP")
+		return nil, valueError("invalid IP")
+	}
+	if err := checkIPFamily(parsed, ipv4, ipv6); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+func parseIPNet(s string, ipv4, ipv6 bool) (*net.IPNet, error) {
+	_, parsed, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, valueError("invalid CIDR")
+	}
+	if err := checkIPFamily(parsed.IP, ipv4, ipv6); err != nil {
+		return nil, err
 	}
 	return parsed, nil
-}
\ No newline at end of file
+}
+
+// checkIPFamily checks that the IP belongs to the permitted family; permitting both or neither permits either.
+func checkIPFamily(ip net.IP, ipv4, ipv6 bool) error {
+	switch isV4 := ip.To4() != nil; {
+	case ipv4 && !ipv6 && !isV4:
+		return valueError("must be an IPv4 address")
+	case ipv6 && !ipv4 && isV4:
+		return valueError("must be an IPv6 address")
+	}
+	return nil
+}
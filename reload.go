@@ -0,0 +1,184 @@
+package envcfg
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Change is a change in the value of a variable found by Cfg.Reload.
+type Change struct {
+	Name string
+	// Old and New are the values before and after the change, of the type returned when the variable was extracted;
+	// either is nil if the variable was unset and optional. Both are always nil if the variable is Sensitive.
+	Old, New interface{}
+	// Reloadable is false unless the variable was marked Reloadable; changes to other variables only take effect on
+	// restart.
+	Reloadable bool
+	// Sensitive is set for Secret variables, whose values are never included.
+	Sensitive bool
+}
+
+// ChangeSet is the set of changes found by a single reload, in the order the variables were declared.
+type ChangeSet []Change
+
+// RequiresRestart reports whether any of the changes were to variables which aren't Reloadable.
+func (cs ChangeSet) RequiresRestart() bool {
+	for _, c := range cs {
+		if !c.Reloadable {
+			return true
+		}
+	}
+	return false
+}
+
+// Get returns the change to the named variable, if any.
+func (cs ChangeSet) Get(name string) (Change, bool) {
+	for _, c := range cs {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Change{}, false
+}
+
+// watched records the value of every variable extracted, so that reloads can tell what changed.
+type watched struct {
+	mu          sync.Mutex
+	bindings    []*binding
+	subscribers []func(ChangeSet, error)
+}
+
+type binding struct {
+	spec  *spec
+	value interface{}
+}
+
+func (c *Cfg) watch(s *spec, val interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.bindings = append(c.bindings, &binding{spec: s, value: val})
+}
+
+// Subscribe registers a function to be called by Watch after every reload which finds changes or fails. If the reload
+// failed, err is non-nil, the ChangeSet is empty and the previous values remain current.
+func (c *Cfg) Subscribe(f func(changes ChangeSet, err error)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscribers = append(c.subscribers, f)
+}
+
+// Reload re-evaluates every variable extracted against the sources and returns the changes since they were extracted
+// or last reloaded. If any variable is missing or invalid, no changes are applied and the errors are returned, as
+// from Err.
+//
+// Sources supporting Reload -- e.g. those of Reloaders -- are re-read first, and the variables evaluated against what
+// was read, so that a Reloader swapped concurrently can't provide a mix of old and new values. What was read is only
+// applied to the sources if every variable is valid; if re-reading any source fails, its error is returned and no
+// source is changed.
+//
+// Changes to variables which aren't Reloadable are returned too -- see ChangeSet.RequiresRestart -- and, like the
+// others, are only reported once.
+func (c *Cfg) Reload() (ChangeSet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var (
+		srcs    = append(sources(nil), c.sources...)
+		commits []func()
+	)
+	for i, s := range srcs {
+		if s.Reload == nil {
+			continue
+		}
+		var (
+			commit func()
+			err    error
+		)
+		if srcs[i], commit, err = s.Reload(); err != nil {
+			return nil, err
+		}
+		commits = append(commits, commit)
+	}
+
+	var (
+		values = make([]interface{}, len(c.bindings))
+		errs   []error
+	)
+	for i, b := range c.bindings {
+		val, _, err := b.spec.evaluate(srcs)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		values[i] = val
+	}
+	if len(errs) > 0 {
+		return nil, c.errMaker(errs)
+	}
+	for _, commit := range commits {
+		commit()
+	}
+
+	var changes ChangeSet
+	for i, b := range c.bindings {
+		if reflect.DeepEqual(b.value, values[i]) {
+			continue
+		}
+		change := Change{
+			Name:       b.spec.name,
+			Old:        b.value,
+			New:        values[i],
+			Reloadable: b.spec.flags&flagReloadable > 0,
+			Sensitive:  b.spec.flags&flagSecret > 0,
+		}
+		if change.Sensitive {
+			change.Old, change.New = nil, nil
+		}
+		changes = append(changes, change)
+		b.value = values[i]
+	}
+	return changes, nil
+}
+
+// Watch reloads the variables every interval, if positive, and whenever one of the signals is received -- e.g.
+// syscall.SIGHUP, as conventional for re-reading configuration files -- until the context is done, notifying
+// subscribers of any changes or errors. It returns the context's error.
+//
+// Watch may run concurrently with Reload and Subscribe, but not with the declaration of further variables.
+func (c *Cfg) Watch(ctx context.Context, interval time.Duration, sigs ...os.Signal) error {
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	var sig chan os.Signal
+	if len(sigs) > 0 {
+		sig = make(chan os.Signal, 1)
+		signal.Notify(sig, sigs...)
+		defer signal.Stop(sig)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-tick:
+		case <-sig:
+		}
+		changes, err := c.Reload()
+		if len(changes) == 0 && err == nil {
+			continue
+		}
+		c.mu.Lock()
+		subscribers := append([]func(ChangeSet, error){}, c.subscribers...)
+		c.mu.Unlock()
+		for _, f := range subscribers {
+			f(changes, err)
+		}
+	}
+}
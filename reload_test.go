@@ -0,0 +1,133 @@
+package envcfg_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jwilner/envcfg"
+)
+
+// mutableEnv is an environment which may be changed while a Cfg watches it.
+type mutableEnv struct {
+	mu   sync.Mutex
+	vars map[string]string
+}
+
+func (e *mutableEnv) set(k, v string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.vars[k] = v
+}
+
+func (e *mutableEnv) lookup(k string) (string, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	v, ok := e.vars[k]
+	return v, ok
+}
+
+func TestCfg_Reload(t *testing.T) {
+	env := &mutableEnv{vars: map[string]string{"LOG_LEVEL": "info", "PORT": "80"}}
+	c := envcfg.New(envcfg.EnvFunc(env.lookup))
+	_ = c.String("LOG_LEVEL reloadable")
+	_ = c.Int("PORT")
+	_ = c.Duration("TIMEOUT optional", envcfg.Reloadable)
+
+	if changes, err := c.Reload(); err != nil || changes != nil {
+		t.Fatalf("Reload() without changes = %v, %v", changes, err)
+	}
+
+	env.set("LOG_LEVEL", "debug")
+	env.set("TIMEOUT", "5s")
+	changes, err := c.Reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := envcfg.ChangeSet{
+		{Name: "LOG_LEVEL", Old: "info", New: "debug", Reloadable: true},
+		{Name: "TIMEOUT", Old: nil, New: 5 * time.Second, Reloadable: true},
+	}
+	if !reflect.DeepEqual(want, changes) {
+		t.Errorf("Reload() = %#v, want %#v", changes, want)
+	}
+	if changes.RequiresRestart() {
+		t.Error("Reload() of reloadable variables requires restart")
+	}
+
+	env.set("PORT", "zz")
+	env.set("LOG_LEVEL", "warn")
+	if changes, err := c.Reload(); err == nil || err.Error() != `PORT: invalid value "zz": invalid syntax` {
+		t.Errorf("Reload() of an invalid value = %v, %v", changes, err)
+	}
+
+	env.set("PORT", "8080")
+	changes, err = c.Reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change, _ := changes.Get("LOG_LEVEL"); change.Old != "debug" || change.New != "warn" {
+		t.Errorf("Reload() after an invalid value = %v, want the previous value kept", changes)
+	}
+	if change, _ := changes.Get("PORT"); change.New != int64(8080) || !changes.RequiresRestart() {
+		t.Errorf("Reload() of PORT = %v, want a change requiring restart", changes)
+	}
+}
+
+func TestCfg_Reload_secret(t *testing.T) {
+	env := &mutableEnv{vars: map[string]string{"API_KEY": "hunter2"}}
+	c := envcfg.New(envcfg.EnvFunc(env.lookup))
+	_ = c.String("API_KEY secret reloadable")
+
+	env.set("API_KEY", "hunter3")
+	changes, err := c.Reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := envcfg.ChangeSet{{Name: "API_KEY", Reloadable: true, Sensitive: true}}
+	if !reflect.DeepEqual(want, changes) {
+		t.Errorf("Reload() = %#v, want %#v", changes, want)
+	}
+
+	if changes, err := c.Reload(); err != nil || changes != nil {
+		t.Errorf("Reload() after a secret change = %v, %v, want it reported once", changes, err)
+	}
+}
+
+func TestCfg_Watch(t *testing.T) {
+	env := &mutableEnv{vars: map[string]string{"LOG_LEVEL": "info"}}
+	c := envcfg.New(envcfg.EnvFunc(env.lookup))
+	_ = c.String("LOG_LEVEL reloadable")
+
+	received := make(chan envcfg.ChangeSet)
+	c.Subscribe(func(changes envcfg.ChangeSet, err error) {
+		if err != nil {
+			t.Error(err)
+		}
+		received <- changes
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- c.Watch(ctx, time.Millisecond)
+	}()
+
+	env.set("LOG_LEVEL", "debug")
+	select {
+	case changes := <-received:
+		want := envcfg.ChangeSet{{Name: "LOG_LEVEL", Old: "info", New: "debug", Reloadable: true}}
+		if !reflect.DeepEqual(want, changes) {
+			t.Errorf("Watch() delivered %v, want %v", changes, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Watch() delivered no changes")
+	}
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Watch() = %v, want %v", err, context.Canceled)
+	}
+}
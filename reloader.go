@@ -0,0 +1,134 @@
+package envcfg
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Reloader is a Source backed by dotenv files or directories of files which are re-read on Reload and swapped in
+// atomically, but only if they're valid: a failed reload leaves the previous snapshot in place.
+//
+// Validity is decided by the load function provided on construction, which is given a Source reading from the
+// candidate files and returns whatever the consumer wants to snapshot -- typically the result of extracting its
+// configuration from a Cfg using the Source, or the Cfg's errors:
+//
+//	r, err := envcfg.NewDotEnvReloader(func(src envcfg.Source) (interface{}, error) {
+//		c := envcfg.New(envcfg.Sources(envcfg.ProcessEnv(), src))
+//		conf := configure(c)
+//		return conf, c.Err()
+//	}, ".env")
+//
+// A Cfg reading from the Reloader's Source re-reads the files itself on Cfg.Reload, swapping them in only if they're
+// valid for both load and the Cfg, so the files are typically re-read on SIGHUP by watching the Cfg, whose subscribers
+// are notified of the changes or errors:
+//
+//	c := envcfg.New(envcfg.Sources(envcfg.ProcessEnv(), r.Source()))
+//	conf := configure(c)
+//	go c.Watch(ctx, 0, syscall.SIGHUP)
+type Reloader struct {
+	name string
+	read func() (map[string]string, error)
+	load func(Source) (interface{}, error)
+
+	mu      sync.Mutex // serializes reads and swaps
+	current atomic.Value
+}
+
+type reloaderSnapshot struct {
+	vars  map[string]string
+	value interface{}
+}
+
+// NewDotEnvReloader returns a Reloader named "file:" followed by the comma separated file names, reading the dotenv
+// files as DotEnvFunc does. It returns an error if the files can't be read or load fails.
+func NewDotEnvReloader(load func(Source) (interface{}, error), files ...string) (*Reloader, error) {
+	return newReloader("file:"+strings.Join(files, ","), func() (map[string]string, error) {
+		return readDotEnv(files)
+	}, load)
+}
+
+// NewDirReloader returns a Reloader named "dir:" followed by the comma separated directory names, reading the
+// directories as DirFunc does. It returns an error if the directories can't be read or load fails.
+func NewDirReloader(load func(Source) (interface{}, error), dirs ...string) (*Reloader, error) {
+	return newReloader("dir:"+strings.Join(dirs, ","), func() (map[string]string, error) {
+		return readDirs(dirs)
+	}, load)
+}
+
+func newReloader(
+	name string, read func() (map[string]string, error), load func(Source) (interface{}, error),
+) (*Reloader, error) {
+	r := &Reloader{name: name, read: read, load: load}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Source returns a Source reading from the current snapshot, whichever it is when each variable is looked up. Its
+// Reload re-reads the files and calls load with them, returning a function swapping them in if both succeed.
+func (r *Reloader) Source() Source {
+	return Source{
+		Name: r.name,
+		Lookup: func(s string) (string, bool) {
+			v, ok := r.snapshot().vars[s]
+			return v, ok
+		},
+		List: func() []string {
+			return mapSource(r.name, r.snapshot().vars).List()
+		},
+		Reload: func() (Source, func(), error) {
+			r.mu.Lock()
+			snap, err := r.prepare()
+			r.mu.Unlock()
+			if err != nil {
+				return Source{}, nil, err
+			}
+			return mapSource(r.name, snap.vars), func() {
+				r.mu.Lock()
+				defer r.mu.Unlock()
+				r.current.Store(snap)
+			}, nil
+		},
+	}
+}
+
+// Snapshot returns the value returned by load for the current snapshot.
+func (r *Reloader) Snapshot() interface{} {
+	return r.snapshot().value
+}
+
+func (r *Reloader) snapshot() *reloaderSnapshot {
+	return r.current.Load().(*reloaderSnapshot)
+}
+
+// Reload re-reads the files and calls load with them, replacing the current snapshot if both succeed. Otherwise, the
+// current snapshot is kept and the error -- an Errors aggregating every problem, if load returned one -- is returned.
+func (r *Reloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snap, err := r.prepare()
+	if err != nil {
+		return err
+	}
+	r.current.Store(snap)
+	return nil
+}
+
+// prepare re-reads the files and calls load with them, returning the snapshot without swapping it in. It must be
+// called with mu held.
+func (r *Reloader) prepare() (*reloaderSnapshot, error) {
+	vars, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+	snap := &reloaderSnapshot{vars: vars}
+	if r.load != nil {
+		if snap.value, err = r.load(mapSource(r.name, vars)); err != nil {
+			return nil, err
+		}
+	}
+	return snap, nil
+}
@@ -0,0 +1,190 @@
+package envcfg_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jwilner/envcfg"
+)
+
+type serverConfig struct {
+	Port     int64
+	LogLevel string
+}
+
+func loadServerConfig(src envcfg.Source) (interface{}, error) {
+	c := envcfg.New(envcfg.Panic(false), envcfg.Sources(src))
+	conf := serverConfig{
+		Port:     c.Int("PORT"),
+		LogLevel: c.String("LOG_LEVEL oneof=debug,info"),
+	}
+	return conf, c.Err()
+}
+
+func TestReloader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	write := func(content string) {
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("PORT=80\nLOG_LEVEL=info\n")
+	r, err := envcfg.NewDotEnvReloader(loadServerConfig, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := r.Source()
+	if want := (serverConfig{80, "info"}); r.Snapshot() != want {
+		t.Errorf("Snapshot() = %v, want %v", r.Snapshot(), want)
+	}
+
+	write("PORT=zz\nLOG_LEVEL=trace\n")
+	err = r.Reload()
+	want := `2 errors: PORT: invalid value "zz": invalid syntax; ` +
+		`LOG_LEVEL: invalid value "trace": must be one of debug,info`
+	if err == nil || err.Error() != want {
+		t.Errorf("Reload() err = %v, want %v", err, want)
+	}
+	if want := (serverConfig{80, "info"}); r.Snapshot() != want {
+		t.Errorf("Snapshot() after a failed reload = %v, want %v", r.Snapshot(), want)
+	}
+	if v, _ := src.Lookup("LOG_LEVEL"); v != "info" {
+		t.Errorf("Lookup() after a failed reload = %q, want %q", v, "info")
+	}
+
+	write("PORT=8080\nLOG_LEVEL=debug\n")
+	if err := r.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if want := (serverConfig{8080, "debug"}); r.Snapshot() != want {
+		t.Errorf("Snapshot() after a reload = %v, want %v", r.Snapshot(), want)
+	}
+	if v, _ := src.Lookup("LOG_LEVEL"); v != "debug" {
+		t.Errorf("Lookup() after a reload = %q, want %q", v, "debug")
+	}
+
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Reload(); !os.IsNotExist(err) {
+		t.Errorf("Reload() of a missing file err = %v, want not exist", err)
+	}
+	if _, err := envcfg.NewDotEnvReloader(loadServerConfig, path); !os.IsNotExist(err) {
+		t.Errorf("NewDotEnvReloader() of a missing file err = %v, want not exist", err)
+	}
+}
+
+func TestReloader_Cfg(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("PORT", "80")
+	write("LOG_LEVEL", "info")
+
+	r, err := envcfg.NewDirReloader(loadServerConfig, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := envcfg.New(envcfg.Sources(r.Source()))
+	_ = c.Int("PORT")
+	_ = c.String("LOG_LEVEL oneof=debug,info reloadable")
+
+	write("LOG_LEVEL", "debug\n")
+	changes, err := c.Reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := envcfg.ChangeSet{{Name: "LOG_LEVEL", Old: "info", New: "debug", Reloadable: true}}
+	if !reflect.DeepEqual(want, changes) {
+		t.Errorf("Reload() = %v, want %v", changes, want)
+	}
+	if want := (serverConfig{80, "debug"}); r.Snapshot() != want {
+		t.Errorf("Snapshot() after Cfg.Reload() = %v, want %v", r.Snapshot(), want)
+	}
+
+	write("LOG_LEVEL", "trace")
+	if changes, err := c.Reload(); err == nil || changes != nil {
+		t.Errorf("Reload() of an invalid file = %v, %v, want an error", changes, err)
+	}
+	if v, _ := r.Source().Lookup("LOG_LEVEL"); v != "debug" {
+		t.Errorf("Lookup() after a failed Cfg.Reload() = %q, want %q", v, "debug")
+	}
+}
+
+func TestReloader_CfgWithoutLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := ioutil.WriteFile(path, []byte("PORT=80\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	r, err := envcfg.NewDotEnvReloader(nil, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := envcfg.New(envcfg.Sources(r.Source()))
+	_ = c.Int("PORT")
+
+	if err := ioutil.WriteFile(path, []byte("PORT=zz\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if changes, err := c.Reload(); err == nil || changes != nil {
+		t.Errorf("Reload() of an invalid file = %v, %v, want an error", changes, err)
+	}
+	if v, _ := r.Source().Lookup("PORT"); v != "80" {
+		t.Errorf("Lookup() after a failed Cfg.Reload() = %q, want %q", v, "80")
+	}
+}
+
+func TestReloader_CfgMultipleSources(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	portPath, hostPath := write("port.env", "PORT=80\n"), write("host.env", "HOST=localhost\n")
+
+	ports, err := envcfg.NewDotEnvReloader(nil, portPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hosts, err := envcfg.NewDotEnvReloader(nil, hostPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := envcfg.New(envcfg.Sources(ports.Source(), hosts.Source()))
+	_ = c.Int("PORT reloadable")
+	_ = c.String("HOST")
+
+	write("port.env", "PORT=81\n")
+	if err := os.Remove(hostPath); err != nil {
+		t.Fatal(err)
+	}
+	if changes, err := c.Reload(); !os.IsNotExist(err) || changes != nil {
+		t.Errorf("Reload() of a missing file = %v, %v, want not exist", changes, err)
+	}
+	if v, _ := ports.Source().Lookup("PORT"); v != "80" {
+		t.Errorf("Lookup() after a failed Cfg.Reload() = %q, want %q", v, "80")
+	}
+
+	write("host.env", "HOST=localhost\n")
+	changes, err := c.Reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := envcfg.ChangeSet{{Name: "PORT", Old: int64(80), New: int64(81), Reloadable: true}}
+	if !reflect.DeepEqual(want, changes) {
+		t.Errorf("Reload() = %v, want %v", changes, want)
+	}
+	if v, _ := ports.Source().Lookup("PORT"); v != "81" {
+		t.Errorf("Lookup() after a Cfg.Reload() = %q, want %q", v, "81")
+	}
+}
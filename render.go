@@ -0,0 +1,281 @@
+package envcfg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// RenderMarkdown writes the descriptions as a Markdown table, e.g. for inclusion in a README.
+func RenderMarkdown(w io.Writer, descs []Description) error {
+	escape := strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>").Replace
+	code := func(s string) string {
+		if s == "" {
+			return ""
+		}
+		return "`" + escape(s) + "`"
+	}
+
+	var b bytes.Buffer
+	b.WriteString("| Name | Type | Required | Default | Params | Description |\n")
+	b.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+	for _, d := range descs {
+		req := "yes"
+		if !required(d) {
+			req = "no"
+		}
+		params, err := renderParams(d)
+		if err != nil {
+			return fmt.Errorf("%v: %w", d.Name, err)
+		}
+		fmt.Fprintf(
+			&b,
+			"| %v | %v | %v | %v | %v | %v |\n",
+			code(d.Name), code(d.Type), req, code(renderDefault(d)), code(params), escape(d.Comment),
+		)
+	}
+	_, err := b.WriteTo(w)
+	return err
+}
+
+// RenderText writes the descriptions as an aligned plain-text table suitable for a --help message. Each variable's
+// options are written in the same syntax accepted in the variable's docOpts.
+func RenderText(w io.Writer, descs []Description) error {
+	var b bytes.Buffer
+	tw := tabwriter.NewWriter(&b, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "VARIABLE\tTYPE\tOPTIONS\tDESCRIPTION")
+	for _, d := range descs {
+		opts, err := renderOpts(d)
+		if err != nil {
+			return fmt.Errorf("%v: %w", d.Name, err)
+		}
+		comment := strings.Join(strings.Fields(d.Comment), " ")
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\n", d.Name, d.Type, opts, comment)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	// trailing empty cells are still padded
+	lines := strings.SplitAfter(b.String(), "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " \n") + "\n"
+	}
+	_, err := io.WriteString(w, strings.Join(lines[:len(lines)-1], ""))
+	return err
+}
+
+// RenderMan writes the descriptions as the ENVIRONMENT section of a man page.
+func RenderMan(w io.Writer, descs []Description) error {
+	var b bytes.Buffer
+	b.WriteString(".SH ENVIRONMENT\n")
+	for _, d := range descs {
+		details := []string{d.Type, "required"}
+		if !required(d) {
+			details[1] = "optional"
+		}
+		if d.Sensitive {
+			details = append(details, "secret")
+		}
+		if d.FileOK {
+			details = append(details, "file_ok")
+		}
+		if d.Reloadable {
+			details = append(details, "reloadable")
+		}
+		if d.Default != nil {
+			details = append(details, "default="+quoteOpt(renderDefault(d)))
+		}
+		params, err := renderParams(d)
+		if err != nil {
+			return fmt.Errorf("%v: %w", d.Name, err)
+		}
+		if params != "" {
+			details = append(details, params)
+		}
+
+		fmt.Fprintf(&b, ".TP\n.B %v\n", manEscape(d.Name))
+		fmt.Fprintf(&b, "(%v)\n", manEscape(strings.Join(details, ", ")))
+		if d.Comment != "" {
+			fmt.Fprintf(&b, "%v\n", manEscape(d.Comment))
+		}
+	}
+	_, err := b.WriteTo(w)
+	return err
+}
+
+// RenderDotEnv writes the descriptions as a commented dotenv file, e.g. a .env.example. Each variable is preceded by its
+// comment and a line noting its type, whether it's required and its params. Variables which are optional or have a
+// default are written commented out, with their default if any.
+func RenderDotEnv(w io.Writer, descs []Description) error {
+	var b bytes.Buffer
+	for i, d := range descs {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		if d.Comment != "" {
+			for _, l := range strings.Split(d.Comment, "\n") {
+				b.WriteString(strings.TrimRight("# "+l, " ") + "\n")
+			}
+		}
+
+		details := []string{d.Type, "required"}
+		if !required(d) {
+			details[1] = "optional"
+		}
+		if d.Sensitive {
+			details = append(details, "secret")
+		}
+		if d.FileOK {
+			details = append(details, "file_ok")
+		}
+		if d.Reloadable {
+			details = append(details, "reloadable")
+		}
+		params, err := renderParams(d)
+		if err != nil {
+			return fmt.Errorf("%v: %w", d.Name, err)
+		}
+		if params != "" {
+			details = append(details, params)
+		}
+		fmt.Fprintf(&b, "# %v\n", strings.Join(details, ", "))
+
+		if !required(d) {
+			b.WriteString("# ")
+		}
+		fmt.Fprintf(&b, "%v=%v\n", d.Name, quoteDotEnv(renderDefault(d)))
+	}
+	_, err := b.WriteTo(w)
+	return err
+}
+
+// renderOpts renders the options of the description as they'd be written in docOpts.
+func renderOpts(d Description) (string, error) {
+	var opts []string
+	if d.Optional {
+		opts = append(opts, "optional")
+	}
+	if d.Sensitive {
+		opts = append(opts, "secret")
+	}
+	if d.FileOK {
+		opts = append(opts, "file_ok")
+	}
+	if d.Reloadable {
+		opts = append(opts, "reloadable")
+	}
+	if d.Default != nil {
+		opts = append(opts, "default="+quoteOpt(renderDefault(d)))
+	}
+	params, err := renderParams(d)
+	if err != nil {
+		return "", err
+	}
+	if params != "" {
+		opts = append(opts, params)
+	}
+	return strings.Join(opts, " "), nil
+}
+
+func renderDefault(d Description) string {
+	if d.Default == nil {
+		return ""
+	}
+	return d.Default.String
+}
+
+// renderParams renders the parser params of the description as space separated docOpts fields, sorted by key.
+func renderParams(d Description) (string, error) {
+	params, err := paramsMap(d)
+	if err != nil {
+		return "", err
+	}
+
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	fields := make([]string, 0, len(keys))
+	for _, k := range keys {
+		fields = append(fields, k+"="+quoteOpt(renderParam(params[k])))
+	}
+	return strings.Join(fields, " "), nil
+}
+
+func renderParam(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case []interface{}:
+		elems := make([]string, len(v))
+		for i, e := range v {
+			elems[i] = renderParam(e)
+		}
+		return strings.Join(elems, ",")
+	case map[string]interface{}:
+		b, _ := json.Marshal(v)
+		return string(b)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// paramsMap returns the params of the description keyed by their JSON names, which match their docOpts keys.
+func paramsMap(d Description) (map[string]interface{}, error) {
+	if d.Params == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(d.Params)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var params map[string]interface{}
+	if err := dec.Decode(&params); err != nil {
+		return nil, fmt.Errorf("params must be a JSON object: %w", err)
+	}
+	return params, nil
+}
+
+// quoteOpt quotes the value if necessary for it to be parsed as a single docOpts field.
+func quoteOpt(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\r\n\"|") {
+		return s
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// quoteDotEnv quotes the value if necessary for it to be read back verbatim by DotEnvFunc.
+func quoteDotEnv(s string) string {
+	if !strings.ContainsAny(s, " \t\r\n\"'#$\\") {
+		return s
+	}
+	return `"` + strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		`$`, `\$`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	).Replace(s) + `"`
+}
+
+func manEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\e`)
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		if strings.HasPrefix(l, ".") || strings.HasPrefix(l, "'") {
+			lines[i] = `\&` + l
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,138 @@
+package envcfg_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jwilner/envcfg"
+)
+
+func TestRender(t *testing.T) {
+	c := envcfg.New(envcfg.Panic(false), envcfg.EnvFunc(func(string) (string, bool) { return "", false }))
+	_ = c.Int("HEX_INT base=16 default=1f | A hex int")
+	_ = c.IntSlice("INTS comma=: optional | Some ints | really")
+	_ = c.String(`GREETING default="hello world"`)
+	_ = c.Time(".DOTTED layout=2006-01-02 optional")
+	_ = c.IntSlice("DEFAULT_INTS base=16 comma=: | Typed default", envcfg.IntSliceDefault([]int64{10, 11, 12}))
+	_ = c.String(`QUOTED default="a \"$b\" #c"`)
+	_ = c.String("TOKEN secret | An API token")
+	descriptions := c.Describe()
+
+	tests := []struct {
+		name   string
+		render func(io.Writer, []envcfg.Description) error
+		want   string
+	}{
+		{
+			name:   "markdown",
+			render: envcfg.RenderMarkdown,
+			want: "| Name | Type | Required | Default | Params | Description |\n" +
+				"| --- | --- | --- | --- | --- | --- |\n" +
+				"| `HEX_INT` | `int64` | no | `1f` | `base=16` | A hex int |\n" +
+				"| `INTS` | `[]int64` | no |  | `comma=:` | Some ints \\| really |\n" +
+				"| `GREETING` | `string` | no | `hello world` |  |  |\n" +
+				"| `.DOTTED` | `time.Time` | no |  | `layout=2006-01-02` |  |\n" +
+				"| `DEFAULT_INTS` | `[]int64` | no | `a:b:c` | `base=16 comma=:` | Typed default |\n" +
+				"| `QUOTED` | `string` | no | `a \"$b\" #c` |  |  |\n" +
+				"| `TOKEN` | `string` | yes |  |  | An API token |\n",
+		},
+		{
+			name:   "text",
+			render: envcfg.RenderText,
+			want: "VARIABLE      TYPE       OPTIONS                        DESCRIPTION\n" +
+				"HEX_INT       int64      default=1f base=16             A hex int\n" +
+				"INTS          []int64    optional comma=:               Some ints | really\n" +
+				"GREETING      string     default=\"hello world\"\n" +
+				".DOTTED       time.Time  optional layout=2006-01-02\n" +
+				"DEFAULT_INTS  []int64    default=a:b:c base=16 comma=:  Typed default\n" +
+				"QUOTED        string     default=\"a \\\"$b\\\" #c\"\n" +
+				"TOKEN         string     secret                         An API token\n",
+		},
+		{
+			name:   "man",
+			render: envcfg.RenderMan,
+			want: ".SH ENVIRONMENT\n" +
+				".TP\n.B HEX_INT\n(int64, optional, default=1f, base=16)\nA hex int\n" +
+				".TP\n.B INTS\n([]int64, optional, comma=:)\nSome ints | really\n" +
+				".TP\n.B GREETING\n(string, optional, default=\"hello world\")\n" +
+				".TP\n.B \\&.DOTTED\n(time.Time, optional, layout=2006-01-02)\n" +
+				".TP\n.B DEFAULT_INTS\n([]int64, optional, default=a:b:c, base=16 comma=:)\nTyped default\n" +
+				".TP\n.B QUOTED\n(string, optional, default=\"a \\e\"$b\\e\" #c\")\n" +
+				".TP\n.B TOKEN\n(string, required, secret)\nAn API token\n",
+		},
+		{
+			name:   "dotenv",
+			render: envcfg.RenderDotEnv,
+			want: "# A hex int\n# int64, optional, base=16\n# HEX_INT=1f\n\n" +
+				"# Some ints | really\n# []int64, optional, comma=:\n# INTS=\n\n" +
+				"# string, optional\n# GREETING=\"hello world\"\n\n" +
+				"# time.Time, optional, layout=2006-01-02\n# .DOTTED=\n\n" +
+				"# Typed default\n# []int64, optional, base=16 comma=:\n# DEFAULT_INTS=a:b:c\n\n" +
+				"# string, optional\n# QUOTED=\"a \\\"\\$b\\\" #c\"\n\n" +
+				"# An API token\n# string, required, secret\nTOKEN=\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := tt.render(&b, descriptions); err != nil {
+				t.Fatal(err)
+			}
+			if got := b.String(); got != tt.want {
+				t.Errorf("render() got =\n%v\nwant =\n%v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderDotEnv_roundTrip(t *testing.T) {
+	configure := func(c *envcfg.Cfg) []interface{} {
+		return []interface{}{
+			c.IntSlice("INTS base=16 comma=:", envcfg.IntSliceDefault([]int64{10, 11, 12})),
+			c.Bytes("BYTES url_safe=true", envcfg.BytesDefault([]byte{0xfb, 0xff})),
+			c.Time("TIME layout=2006-01-02", envcfg.TimeDefault(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))),
+			c.Float("FLOAT bit_size=32", envcfg.FloatDefault(float64(float32(0.1)))),
+			c.Duration("DURATION", envcfg.DurationDefault(90*time.Second)),
+			c.StringSlice("STRINGS", envcfg.StringSliceDefault([]string{"a b", `"c"`, "$d"})),
+			c.StringMap("HEADERS", envcfg.StringMapDefault(map[string]string{"X-A": "b c", "X-D": "e,f"})),
+		}
+	}
+
+	c := envcfg.New(envcfg.Panic(false), envcfg.EnvFunc(func(string) (string, bool) { return "", false }))
+	want := configure(c)
+
+	var b bytes.Buffer
+	if err := envcfg.RenderDotEnv(&b, c.Describe()); err != nil {
+		t.Fatal(err)
+	}
+	uncommented := regexp.MustCompile(`(?m)^# ([A-Z]+=)`).ReplaceAllString(b.String(), "$1")
+
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := ioutil.WriteFile(path, []byte(uncommented), 0600); err != nil {
+		t.Fatal(err)
+	}
+	envFunc, err := envcfg.DotEnvFunc(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c = envcfg.New(envcfg.Panic(false), envcfg.EnvFunc(envFunc))
+	got := configure(c)
+	if err := c.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("RenderDotEnv() round trip got %v, want %v\n%v", got, want, uncommented)
+	}
+	for _, d := range c.Describe() {
+		if d.Provenance != "env" {
+			t.Errorf("%v: expected to be read from the rendered file, got %q", d.Name, d.Provenance)
+		}
+	}
+}
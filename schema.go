@@ -0,0 +1,243 @@
+package envcfg
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// JSONSchema converts the descriptions to a JSON Schema (draft-07) document describing an object whose properties are the
+// variables, suitable for validating e.g. the environment section of a deployment manifest.
+//
+// Since environment variables are strings, every variable accepts a string matching the syntax its parser accepts,
+// expressed as a pattern or format where possible; booleans and numbers additionally accept their native JSON types,
+// constrained by their bit sizes and any min and max. A String's oneof and regex are expressed as an enum and pattern.
+// Variables which are neither optional nor have a default are required; if they're FileOK, NAME_FILE is described too and
+// either one is required, expressed with anyOf -- within an allOf if there are several such variables.
+func JSONSchema(descs []Description) (map[string]interface{}, error) {
+	var (
+		properties = make(map[string]interface{}, len(descs))
+		required   = make([]string, 0, len(descs))
+		eitherOf   []interface{}
+	)
+	for _, d := range descs {
+		prop, err := propertySchema(d)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", d.Name, err)
+		}
+		properties[d.Name] = prop
+		if d.FileOK {
+			// either the variable or its file may be set
+			properties[d.Name+fileSuffix] = map[string]interface{}{"type": "string"}
+		}
+		switch {
+		case d.Optional || hasDefault(d):
+		case d.FileOK:
+			eitherOf = append(eitherOf, []interface{}{
+				map[string]interface{}{"required": []string{d.Name}},
+				map[string]interface{}{"required": []string{d.Name + fileSuffix}},
+			})
+		default:
+			required = append(required, d.Name)
+		}
+	}
+	schema := map[string]interface{}{
+		"$schema":    "http://json-schema.org/draft-07/schema#",
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+	switch len(eitherOf) {
+	case 0:
+	case 1:
+		schema["anyOf"] = eitherOf[0]
+	default:
+		allOf := make([]interface{}, 0, len(eitherOf))
+		for _, anyOf := range eitherOf {
+			allOf = append(allOf, map[string]interface{}{"anyOf": anyOf})
+		}
+		schema["allOf"] = allOf
+	}
+	return schema, nil
+}
+
+var (
+	boolStrings     = []interface{}{true, false, "1", "t", "T", "TRUE", "true", "True", "0", "f", "F", "FALSE", "false", "False"}
+	boolPattern     = `1|t|T|TRUE|true|True|0|f|F|FALSE|false|False`
+	floatPattern    = `[+-]?(([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?|[iI][nN][fF]([iI][nN][iI][tT][yY])?|[nN][aA][nN])`
+	durationPattern = `[+-]?(0|(([0-9]+(\.[0-9]*)?|\.[0-9]+)(ns|us|µs|μs|ms|s|m|h))+)`
+	byteSizePattern = `([0-9]+(\.[0-9]*)?|\.[0-9]+) *([kKmMgGtTpPeE][iI]?[bB]?|[bB])?`
+	base0Pattern    = `0[xX][0-9a-fA-F_]+|0[bB][01_]+|0[oO]?[0-7_]*|[1-9][0-9_]*`
+)
+
+func propertySchema(d Description) (map[string]interface{}, error) {
+	params, err := paramsMap(d)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := map[string]interface{}{"type": "string"}
+	if elem := strings.TrimPrefix(d.Type, "map[string]"); elem != d.Type {
+		// a map: describe the values, then the pairs as a whole
+		if pattern := scalarPattern(elem, params); pattern != "" {
+			comma, sep := ",", "="
+			if c, ok := params["comma"].(string); ok {
+				comma = c
+			}
+			if s, ok := params["key_value_sep"].(string); ok {
+				sep = s
+			}
+			pair := fmt.Sprintf("[^%v%v]*%v(%v)", regexp.QuoteMeta(comma), regexp.QuoteMeta(sep), regexp.QuoteMeta(sep), pattern)
+			schema["pattern"] = fmt.Sprintf("^((%v)(%v(%v))*)?$", pair, regexp.QuoteMeta(comma), pair)
+		}
+	} else if elem := strings.TrimPrefix(d.Type, "[]"); elem != d.Type && elem != "byte" {
+		// a slice: describe the elements, then the list as a whole
+		if pattern := scalarPattern(elem, params); pattern != "" {
+			comma := ","
+			if c, ok := params["comma"].(string); ok {
+				comma = c
+			}
+			comma = regexp.QuoteMeta(comma)
+			schema["pattern"] = fmt.Sprintf("^((%v)(%v(%v))*)?$", pattern, comma, pattern)
+		}
+	} else {
+		scalarSchema(schema, d.Type, params)
+	}
+
+	if d.Comment != "" {
+		schema["description"] = d.Comment
+	}
+	if d.Default != nil {
+		schema["default"] = d.Default.String
+	}
+	return schema, nil
+}
+
+// scalarSchema sets the keywords describing a single value of the type.
+func scalarSchema(schema map[string]interface{}, typ string, params map[string]interface{}) {
+	switch typ {
+	case "bool":
+		schema["type"] = []string{"boolean", "string"}
+		schema["enum"] = boolStrings
+	case "int64", "uint64":
+		schema["type"] = []string{"integer", "string"}
+		min, max := intRange(typ == "int64", intParam(params, "bit_size"))
+		schema["minimum"], schema["maximum"] = min, max
+		for key, kw := range map[string]string{"min": "minimum", "max": "maximum"} {
+			if s, ok := params[key].(string); ok {
+				if typ == "int64" {
+					schema[kw], _ = strconv.ParseInt(s, intParam(params, "base"), 64)
+				} else {
+					schema[kw], _ = strconv.ParseUint(s, intParam(params, "base"), 64)
+				}
+			}
+		}
+	case "ByteSize":
+		schema["type"] = []string{"integer", "string"}
+		schema["minimum"], schema["maximum"] = intRange(false, 64)
+		iec, _ := params["iec"].(bool)
+		for key, kw := range map[string]string{"min": "minimum", "max": "maximum"} {
+			if s, ok := params[key].(string); ok {
+				v, _ := parseByteSize(s, iec)
+				schema[kw] = uint64(v)
+			}
+		}
+	case "float64":
+		schema["type"] = []string{"number", "string"}
+		for key, kw := range map[string]string{"min": "minimum", "max": "maximum"} {
+			if s, ok := params[key].(string); ok {
+				schema[kw], _ = strconv.ParseFloat(s, 64)
+			}
+		}
+	case "string":
+		if oneOf, ok := params["oneof"].([]interface{}); ok {
+			schema["enum"] = oneOf
+		}
+		if regex, ok := params["regex"].(string); ok {
+			schema["pattern"] = regex
+		}
+	case "time.Time":
+		if _, ok := params["layout"]; !ok {
+			schema["format"] = "date-time"
+		}
+	case "net.IP":
+		switch v4, v6 := params["ipv4"] == true, params["ipv6"] == true; {
+		case v4 && !v6:
+			schema["format"] = "ipv4"
+		case v6 && !v4:
+			schema["format"] = "ipv6"
+		default:
+			schema["anyOf"] = []interface{}{
+				map[string]interface{}{"format": "ipv4"},
+				map[string]interface{}{"format": "ipv6"},
+			}
+		}
+	case "[]byte":
+		schema["contentEncoding"] = "base64"
+	case "*url.URL":
+		schema["format"] = "uri-reference"
+		if schemes, ok := params["schemes"].([]interface{}); ok {
+			alts := make([]string, len(schemes))
+			for i, s := range schemes {
+				alts[i] = regexp.QuoteMeta(fmt.Sprint(s))
+			}
+			schema["format"] = "uri"
+			schema["pattern"] = "^(" + strings.Join(alts, "|") + "):"
+		}
+	}
+	if pattern := scalarPattern(typ, params); pattern != "" {
+		schema["pattern"] = "^(" + pattern + ")$"
+	}
+}
+
+// scalarPattern returns an unanchored regular expression matching the string form of the type, if one is known.
+func scalarPattern(typ string, params map[string]interface{}) string {
+	switch typ {
+	case "bool":
+		return boolPattern
+	case "int64":
+		return "[+-]?(" + digitsPattern(intParam(params, "base")) + ")"
+	case "uint64":
+		return digitsPattern(intParam(params, "base"))
+	case "float64":
+		return floatPattern
+	case "time.Duration":
+		return durationPattern
+	case "ByteSize":
+		return byteSizePattern
+	}
+	return ""
+}
+
+// digitsPattern matches an unsigned integer in the base, following the rules of strconv.ParseInt.
+func digitsPattern(base int) string {
+	switch {
+	case base == 0:
+		return base0Pattern
+	case base <= 10:
+		return fmt.Sprintf("[0-%d]+", base-1)
+	default:
+		last := rune('a' + base - 11)
+		return fmt.Sprintf("[0-9a-%cA-%c]+", last, last-'a'+'A')
+	}
+}
+
+// intRange returns the bounds of an integer of the bit size.
+func intRange(signed bool, bitSize int) (min, max interface{}) {
+	if bitSize == 0 {
+		bitSize = 64
+	}
+	if signed {
+		return int64(-1) << (bitSize - 1), int64(1)<<(bitSize-1) - 1
+	}
+	return 0, uint64(math.MaxUint64) >> (64 - bitSize)
+}
+
+func intParam(params map[string]interface{}, key string) int {
+	n, _ := params[key].(json.Number)
+	i, _ := strconv.Atoi(string(n))
+	return i
+}
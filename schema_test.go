@@ -0,0 +1,175 @@
+package envcfg_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/jwilner/envcfg"
+)
+
+func TestJSONSchema(t *testing.T) {
+	c := envcfg.New(envcfg.Panic(false), envcfg.EnvFunc(func(string) (string, bool) { return "", false }))
+	_ = c.Int("PORT bit_size=16 | The port to listen on")
+	_ = c.Uint("MASK base=16 bit_size=8 default=ff")
+	_ = c.Bool("DEBUG optional")
+	_ = c.Duration("TIMEOUT default=5s")
+	_ = c.Time("STARTS")
+	_ = c.IntSlice("INTS comma=: base=2 optional")
+	_ = c.IP("ADDR optional")
+	_ = c.IP("ADDR6 ipv6 optional")
+	_ = c.String("NAME")
+	_ = c.String("LEVEL oneof=debug,info regex=^[a-z]+$ optional")
+	_ = c.Int("WORKERS min=1 max=0x40 optional")
+	_ = c.Float("RATIO min=0 max=1 optional")
+	_ = c.URL("UPSTREAM schemes=http,https optional")
+	_ = c.ByteSize("CACHE_SIZE max=1GiB default=64MiB")
+	_ = c.BoolSlice("FLAGS optional")
+	_ = c.String("DB_PASSWORD file_ok")
+	_ = c.DurationSlice("BACKOFFS comma=; optional")
+	_ = c.IntMap("LIMITS base=2 key_value_sep=: optional")
+
+	schema, err := envcfg.JSONSchema(c.Describe())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got, expected interface{}
+	if b, err := json.Marshal(schema); err != nil {
+		t.Fatal(err)
+	} else if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"type": "object",
+	"properties": {
+		"PORT": {
+			"type": ["integer", "string"],
+			"pattern": "^([+-]?(0[xX][0-9a-fA-F_]+|0[bB][01_]+|0[oO]?[0-7_]*|[1-9][0-9_]*))$",
+			"minimum": -32768,
+			"maximum": 32767,
+			"description": "The port to listen on"
+		},
+		"MASK": {
+			"type": ["integer", "string"],
+			"pattern": "^([0-9a-fA-F]+)$",
+			"minimum": 0,
+			"maximum": 255,
+			"default": "ff"
+		},
+		"DEBUG": {
+			"type": ["boolean", "string"],
+			"enum": [true, false, "1", "t", "T", "TRUE", "true", "True", "0", "f", "F", "FALSE", "false", "False"],
+			"pattern": "^(1|t|T|TRUE|true|True|0|f|F|FALSE|false|False)$"
+		},
+		"TIMEOUT": {
+			"type": "string",
+			"pattern": "^([+-]?(0|(([0-9]+(\\.[0-9]*)?|\\.[0-9]+)(ns|us|µs|μs|ms|s|m|h))+))$",
+			"default": "5s"
+		},
+		"STARTS": {"type": "string", "format": "date-time"},
+		"INTS": {"type": "string", "pattern": "^(([+-]?([0-1]+))(:([+-]?([0-1]+)))*)?$"},
+		"ADDR": {"type": "string", "anyOf": [{"format": "ipv4"}, {"format": "ipv6"}]},
+		"ADDR6": {"type": "string", "format": "ipv6"},
+		"NAME": {"type": "string"},
+		"LEVEL": {"type": "string", "enum": ["debug", "info"], "pattern": "^[a-z]+$"},
+		"WORKERS": {
+			"type": ["integer", "string"],
+			"pattern": "^([+-]?(0[xX][0-9a-fA-F_]+|0[bB][01_]+|0[oO]?[0-7_]*|[1-9][0-9_]*))$",
+			"minimum": 1,
+			"maximum": 64
+		},
+		"RATIO": {
+			"type": ["number", "string"],
+			"pattern": "^([+-]?(([0-9]+(\\.[0-9]*)?|\\.[0-9]+)([eE][+-]?[0-9]+)?|[iI][nN][fF]([iI][nN][iI][tT][yY])?|[nN][aA][nN]))$",
+			"minimum": 0,
+			"maximum": 1
+		},
+		"UPSTREAM": {"type": "string", "format": "uri", "pattern": "^(http|https):"},
+		"CACHE_SIZE": {
+			"type": ["integer", "string"],
+			"pattern": "^(([0-9]+(\\.[0-9]*)?|\\.[0-9]+) *([kKmMgGtTpPeE][iI]?[bB]?|[bB])?)$",
+			"minimum": 0,
+			"maximum": 1073741824,
+			"default": "64MiB"
+		},
+		"FLAGS": {"type": "string", "pattern": "^((1|t|T|TRUE|true|True|0|f|F|FALSE|false|False)(,(1|t|T|TRUE|true|True|0|f|F|FALSE|false|False))*)?$"},
+		"BACKOFFS": {
+			"type": "string",
+			"pattern": "^(([+-]?(0|(([0-9]+(\\.[0-9]*)?|\\.[0-9]+)(ns|us|µs|μs|ms|s|m|h))+))(;([+-]?(0|(([0-9]+(\\.[0-9]*)?|\\.[0-9]+)(ns|us|µs|μs|ms|s|m|h))+)))*)?$"
+		},
+		"DB_PASSWORD": {"type": "string"},
+		"DB_PASSWORD_FILE": {"type": "string"},
+		"LIMITS": {"type": "string", "pattern": "^(([^,:]*:([+-]?([0-1]+)))(,([^,:]*:([+-]?([0-1]+))))*)?$"}
+	},
+	"required": ["PORT", "STARTS", "NAME"],
+	"anyOf": [{"required": ["DB_PASSWORD"]}, {"required": ["DB_PASSWORD_FILE"]}]
+}`), &expected); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("JSONSchema() got %v, want %v", got, expected)
+	}
+
+	patterns := schema["properties"].(map[string]interface{})
+	for name, values := range map[string][]string{
+		"PORT":       {"8080", "-1", "0x1f", "0"},
+		"MASK":       {"ff", "0A"},
+		"TIMEOUT":    {"1h30m", "-1.5s", "0"},
+		"INTS":       {"", "1", "10:-11:0"},
+		"LIMITS":     {"", "a:1", "a:10,b:-11"},
+		"CACHE_SIZE": {"512", "1.5G", "10 MiB", "64kb"},
+		"FLAGS":      {"", "true", "t,0,FALSE"},
+		"BACKOFFS":   {"", "1s", "1s;5s;1m30s"},
+	} {
+		re := regexp.MustCompile(patterns[name].(map[string]interface{})["pattern"].(string))
+		for _, v := range values {
+			if !re.MatchString(v) {
+				t.Errorf("%v: pattern %v does not match %q", name, re, v)
+			}
+		}
+	}
+}
+
+func TestJSONSchema_fileOK(t *testing.T) {
+	c := envcfg.New(envcfg.Panic(false), envcfg.EnvFunc(func(string) (string, bool) { return "", false }))
+	_ = c.String("DB_PASSWORD file_ok")
+	_ = c.String("API_KEY file_ok")
+	_ = c.String("TLS_KEY file_ok optional")
+
+	schema, err := envcfg.JSONSchema(c.Describe())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got, expected interface{}
+	if b, err := json.Marshal(schema); err != nil {
+		t.Fatal(err)
+	} else if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"type": "object",
+	"properties": {
+		"DB_PASSWORD": {"type": "string"},
+		"DB_PASSWORD_FILE": {"type": "string"},
+		"API_KEY": {"type": "string"},
+		"API_KEY_FILE": {"type": "string"},
+		"TLS_KEY": {"type": "string"},
+		"TLS_KEY_FILE": {"type": "string"}
+	},
+	"required": [],
+	"allOf": [
+		{"anyOf": [{"required": ["DB_PASSWORD"]}, {"required": ["DB_PASSWORD_FILE"]}]},
+		{"anyOf": [{"required": ["API_KEY"]}, {"required": ["API_KEY_FILE"]}]}
+	]
+}`), &expected); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("JSONSchema() got %v, want %v", got, expected)
+	}
+}
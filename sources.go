@@ -0,0 +1,135 @@
+package envcfg
+
+import (
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	provenanceEnv     = "env"
+	provenanceDefault = "default"
+)
+
+// Source is a named lookup from which variables may be read; see Sources.
+type Source struct {
+	// Name identifies the source in Description.Provenance.
+	Name string
+	// Lookup returns the value of the variable and whether it was set.
+	Lookup func(string) (string, bool)
+	// List optionally returns the names of all the variables set, allowing Strict to find undeclared ones.
+	List func() []string
+	// Reload optionally re-reads the source -- e.g. its files -- without applying what was read, returning a Source
+	// reading only from it and a function applying it. Cfg.Reload re-evaluates the variables against what every source
+	// read, and only applies it if they're all valid; see Reloader.
+	Reload func() (Source, func(), error)
+}
+
+// Sources specifies an ordered list of sources to read variables from. Each variable is read from the first source in
+// which it is set, and the name of that source is recorded as the variable's Description.Provenance.
+func Sources(srcs ...Source) Option {
+	return func(c *Cfg) {
+		c.sources = append(sources(nil), srcs...)
+	}
+}
+
+// ProcessEnv returns a Source named "env" which reads from the process environment.
+func ProcessEnv() Source {
+	return Source{Name: provenanceEnv, Lookup: os.LookupEnv, List: environNames}
+}
+
+func environNames() []string {
+	var names []string
+	for _, kv := range os.Environ() {
+		if idx := strings.IndexByte(kv, '='); idx > 0 {
+			names = append(names, kv[:idx])
+		}
+	}
+	return names
+}
+
+// MapSource returns a Source reading from the map provided -- e.g. a set of defaults.
+func MapSource(name string, m map[string]string) Source {
+	return mapSource(name, m)
+}
+
+func mapSource(name string, m map[string]string) Source {
+	return Source{
+		Name: name,
+		Lookup: func(s string) (string, bool) {
+			v, ok := m[s]
+			return v, ok
+		},
+		List: func() []string {
+			names := make([]string, 0, len(m))
+			for k := range m {
+				names = append(names, k)
+			}
+			return names
+		},
+	}
+}
+
+// DotEnvSource returns a Source named "file:" followed by the file name, which reads from the dotenv file provided.
+// See DotEnvFunc for the supported syntax.
+func DotEnvSource(file string) (Source, error) {
+	vars, err := readDotEnv([]string{file})
+	if err != nil {
+		return Source{}, err
+	}
+	return mapSource("file:"+file, vars), nil
+}
+
+// DirSource returns a Source named "dir:" followed by the directory name, which reads from the files in the directory
+// provided. See DirFunc for the expected layout.
+func DirSource(dir string) (Source, error) {
+	vars, err := readDirs([]string{dir})
+	if err != nil {
+		return Source{}, err
+	}
+	return mapSource("dir:"+dir, vars), nil
+}
+
+type sources []Source
+
+func (ss sources) lookup(name string) (val, provenance string, ok bool) {
+	for _, s := range ss {
+		if val, ok = s.Lookup(name); ok {
+			return val, s.Name, true
+		}
+	}
+	return "", "", false
+}
+
+// listable reports whether any of the sources support List.
+func (ss sources) listable() bool {
+	for _, s := range ss {
+		if s.List != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// undeclared returns the variables with the prefix which are set in a listable source but not declared, sorted by name.
+func (ss sources) undeclared(prefix string, declared map[string]bool) []*UndeclaredError {
+	var (
+		errs []*UndeclaredError
+		seen = make(map[string]bool)
+	)
+	for _, s := range ss {
+		if s.List == nil {
+			continue
+		}
+		for _, name := range s.List() {
+			if strings.HasPrefix(name, prefix) && !declared[name] && !seen[name] {
+				errs = append(errs, &UndeclaredError{Name: name, Source: s.Name})
+				seen[name] = true
+			}
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool {
+		return errs[i].Name < errs[j].Name
+	})
+	return errs
+}
@@ -0,0 +1,203 @@
+package envcfg_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/jwilner/envcfg"
+)
+
+func TestSources(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := ioutil.WriteFile(path, []byte("A=file\nB=file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	file, err := envcfg.DotEnvSource(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := envcfg.New(
+		envcfg.Panic(false),
+		envcfg.Sources(
+			envcfg.MapSource("env", map[string]string{"A": "env"}),
+			file,
+			envcfg.MapSource("defaults", map[string]string{"A": "defaults", "B": "defaults", "C": "defaults"}),
+		),
+	)
+	got := []string{
+		c.String("A"),
+		c.String("B"),
+		c.String("C"),
+		c.String("D default=default"),
+	}
+	_ = c.String("E optional")
+	_ = c.Has("B")
+	_ = c.HasNot("F")
+
+	descriptions, err := c.Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"env", "file", "defaults", "default"}; !reflect.DeepEqual(want, got) {
+		t.Errorf("Sources() values = %v, want %v", got, want)
+	}
+
+	var provenances []string
+	for _, d := range descriptions {
+		provenances = append(provenances, d.Provenance)
+	}
+	want := []string{"env", "file:" + path, "defaults", "default", "", "file:" + path, "default"}
+	if !reflect.DeepEqual(want, provenances) {
+		t.Errorf("Sources() provenances = %q, want %q", provenances, want)
+	}
+}
+
+func TestFileOK(t *testing.T) {
+	dir := t.TempDir()
+	secret := filepath.Join(dir, "db")
+	if err := ioutil.WriteFile(secret, []byte("hunter2\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	port := filepath.Join(dir, "port")
+	if err := ioutil.WriteFile(port, []byte("5432\r\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	env := map[string]string{
+		"DB_PASSWORD_FILE": secret,
+		"DB_PORT_FILE":     port,
+		"DB_USER":          "direct",
+		"DB_USER_FILE":     secret,
+		"DB_HOST_FILE":     secret,
+		"DB_NAME_FILE":     filepath.Join(dir, "missing"),
+	}
+
+	t.Run("per variable", func(t *testing.T) {
+		c := envcfg.New(envcfg.Panic(false), envcfg.Sources(envcfg.MapSource("env", env)))
+		got := []interface{}{
+			c.String("DB_PASSWORD file_ok secret"),
+			c.Int("DB_PORT", envcfg.FileOK),
+			c.String("DB_USER file_ok"),
+			c.String("DB_HOST optional"),
+		}
+		descriptions, err := c.Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []interface{}{"hunter2", int64(5432), "direct", ""}; !reflect.DeepEqual(want, got) {
+			t.Errorf("FileOK values = %v, want %v", got, want)
+		}
+		var (
+			provenances []string
+			fileOK      []bool
+		)
+		for _, d := range descriptions {
+			provenances = append(provenances, d.Provenance)
+			fileOK = append(fileOK, d.FileOK)
+		}
+		if want := []string{"file:" + secret, "file:" + port, "env", ""}; !reflect.DeepEqual(want, provenances) {
+			t.Errorf("FileOK provenances = %q, want %q", provenances, want)
+		}
+		if want := []bool{true, true, true, false}; !reflect.DeepEqual(want, fileOK) {
+			t.Errorf("FileOK descriptions = %v, want %v", fileOK, want)
+		}
+	})
+
+	t.Run("global", func(t *testing.T) {
+		c := envcfg.New(envcfg.Panic(false), envcfg.Sources(envcfg.MapSource("env", env)), envcfg.FileIndirection(true))
+		if got := c.String("DB_HOST"); got != "hunter2" {
+			t.Errorf("FileIndirection value = %q, want %q", got, "hunter2")
+		}
+		_ = c.String("DB_NAME")
+
+		err := c.Err()
+		var fileErr *envcfg.FileError
+		if !errors.As(err, &fileErr) || fileErr.Name != "DB_NAME" || fileErr.Path != env["DB_NAME_FILE"] {
+			t.Fatalf("expected a FileError for DB_NAME, got %#v", err)
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("expected %v to wrap os.ErrNotExist", err)
+		}
+		if want := "DB_NAME: unreadable file: open " + env["DB_NAME_FILE"] + ": no such file or directory"; err.Error() != want {
+			t.Errorf("FileError message = %q, want %q", err.Error(), want)
+		}
+	})
+
+	t.Run("layered sources", func(t *testing.T) {
+		c := envcfg.New(
+			envcfg.Panic(false),
+			envcfg.Sources(
+				envcfg.MapSource("env", map[string]string{"DB_PASSWORD_FILE": secret, "DB_USER": "env"}),
+				envcfg.MapSource("defaults", map[string]string{"DB_PASSWORD": "dev", "DB_USER_FILE": secret}),
+			),
+		)
+		got := []string{c.String("DB_PASSWORD file_ok"), c.String("DB_USER file_ok")}
+		descriptions, err := c.Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []string{"hunter2", "env"}; !reflect.DeepEqual(want, got) {
+			t.Errorf("layered FileOK values = %q, want %q", got, want)
+		}
+		if got, want := descriptions[0].Provenance, "file:"+secret; got != want {
+			t.Errorf("layered FileOK provenance = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestStrict(t *testing.T) {
+	if err := os.Setenv("ENVCFG_STRICT_TEST_TIMEOUTT", "1s"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("ENVCFG_STRICT_TEST_TIMEOUTT")
+
+	c := envcfg.New(
+		envcfg.Strict("MYAPP_"),
+		envcfg.Strict("ENVCFG_STRICT_TEST_"),
+		envcfg.Sources(
+			envcfg.MapSource("defaults", map[string]string{
+				"MYAPP_HOST":        "localhost",
+				"MYAPP_PORTT":       "80",
+				"MYAPP_SECRET_FILE": "/dev/null",
+				"MYAPP_FLAG":        "",
+				"OTHER_APP_PORTT":   "80",
+			}),
+			envcfg.Source{Name: "unlisted", Lookup: func(string) (string, bool) { return "", false }},
+			envcfg.ProcessEnv(),
+		),
+	)
+	_ = c.String("MYAPP_HOST")
+	_ = c.Int("MYAPP_PORT optional")
+	_ = c.String("MYAPP_SECRET file_ok")
+	_ = c.Has("MYAPP_FLAG")
+	_ = c.Duration("ENVCFG_STRICT_TEST_TIMEOUT default=5s")
+
+	err := c.Err()
+	want := "2 errors: MYAPP_PORTT: undeclared variable set in defaults; " +
+		"ENVCFG_STRICT_TEST_TIMEOUTT: undeclared variable set in env"
+	if err == nil || err.Error() != want {
+		t.Fatalf("Strict() err = %v, want %v", err, want)
+	}
+	var undeclared *envcfg.UndeclaredError
+	if !errors.As(err, &undeclared) || undeclared.Name != "MYAPP_PORTT" || undeclared.Source != "defaults" {
+		t.Errorf("expected an UndeclaredError for MYAPP_PORTT, got %#v", err)
+	}
+
+	_ = c.Int("MYAPP_PORTT")
+	_ = c.Duration("ENVCFG_STRICT_TEST_TIMEOUTT")
+	if err := c.Err(); err != nil {
+		t.Errorf("Strict() err = %v once declared, want nil", err)
+	}
+}
+
+func TestStrict_notListable(t *testing.T) {
+	c := envcfg.New(envcfg.EnvFunc(os.LookupEnv), envcfg.Strict("MYAPP_"))
+	_ = c.String("MYAPP_HOST default=localhost")
+	if err := c.Err(); !errors.Is(err, envcfg.ErrNotListable) {
+		t.Errorf("Strict() err = %v with only EnvFunc, want %v", err, envcfg.ErrNotListable)
+	}
+}
@@ -3,7 +3,7 @@ package envcfg
 // Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
 
 import (
-	"errors"
+	"regexp"
 	"strings"
 )
 
@@ -15,18 +15,19 @@ import (
 //
 // Available options:
 // 		- "default" or StringDefault
+// 		- "file_ok" or FileOK
+// 		- "oneof" or StringOneOf
 // 		- "optional" or Optional
+// 		- "regex" or StringRegex
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 func (c *Cfg) String(docOpts string, opts ...StringOpt) (v string) {
 	s, err := newStringSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).(string)
+	v, _ = c.register(s).(string)
 	return
 }
 
@@ -41,6 +42,20 @@ func StringDefault(def string) StringOpt {
 	return defaultOpt(def)
 }
 
+// StringOneOf specifies the permitted values for a String variable.
+func StringOneOf(oneof []string) StringOpt {
+	return stringOptFunc(func(p *stringParser) {
+		p.oneof = oneof
+	})
+}
+
+// StringRegex specifies a regular expression values must match for a String variable.
+func StringRegex(regex string) StringOpt {
+	return stringOptFunc(func(p *stringParser) {
+		p.regex = regex
+	})
+}
+
 type stringOptFunc func(p *stringParser)
 
 func (f stringOptFunc) modifyStringParser(p *stringParser) {
@@ -54,7 +69,7 @@ var _ StringOpt = new(stringOptFunc)
 func newStringSpec(docOpts string, opts []StringOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(stringParser)
@@ -70,24 +85,22 @@ func newStringSpec(docOpts string, opts []StringOpt) (*spec, error) {
 			opt StringOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
-		case "optional":
-			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
-			}
-			opt = Optional
+		switch key := strings.ToLower(f[0]); key {
+		case "oneof":
+			var val []string
+			val, err = parseSlice(f[1], 0)
+			opt = StringOneOf(val)
+		case "regex":
+
+			opt = StringRegex(f[1])
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyStringParser(p)
@@ -98,9 +111,18 @@ func newStringSpec(docOpts string, opts []StringOpt) (*spec, error) {
 		opt.modifyStringParser(p)
 	}
 
+	if p.regex != "" {
+		if p.re, err = regexp.Compile(p.regex); err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "regex", Err: err}
+		}
+	}
+
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -108,12 +130,41 @@ func newStringSpec(docOpts string, opts []StringOpt) (*spec, error) {
 }
 
 type stringParser struct {
+	oneof []string
+	regex string
+	re    *regexp.Regexp // compiled from regex
 }
 
 func (p *stringParser) parse(s string) (interface{}, error) {
+	if err := p.validate(s); err != nil {
+		return nil, err
+	}
 	return s, nil
 }
 
+func (p *stringParser) validate(v string) error {
+	if len(p.oneof) > 0 && !containsString(p.oneof, v) {
+		return valueErrorf("must be one of %v", strings.Join(p.oneof, ","))
+	}
+	if p.re != nil && !p.re.MatchString(v) {
+		return valueErrorf("must match %v", p.regex)
+	}
+	return nil
+}
+
+func (p *stringParser) format(val interface{}) string {
+	v, _ := val.(string)
+	return v
+}
+
 func (p *stringParser) describe() interface{} {
-	return struct{}{}
+	return stringParserDescription{
+		OneOf: p.oneof,
+		Regex: p.regex,
+	}
+}
+
+type stringParserDescription struct {
+	OneOf []string `json:"oneof,omitempty"`
+	Regex string   `json:"regex,omitempty"`
 }
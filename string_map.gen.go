@@ -0,0 +1,236 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// StringMap extracts and parses a map[string]string variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe StringMapOpts.
+//
+// Available options:
+// 		- "comma" or StringMapComma
+// 		- "default" or StringMapDefault
+// 		- "file_ok" or FileOK
+// 		- "key_value_sep" or StringMapKeyValueSep
+// 		- "max_len" or StringMapMaxLen
+// 		- "min_len" or StringMapMinLen
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) StringMap(docOpts string, opts ...StringMapOpt) (v map[string]string) {
+	s, err := newStringMapSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).(map[string]string)
+	return
+}
+
+// StringMapOpt modifies StringMap variable configuration.
+type StringMapOpt interface {
+	modify(s *spec)
+	modifyStringMapParser(p *stringMapParser)
+}
+
+// StringMapComma specifies the comma to use for a StringMap variable.
+func StringMapComma(comma rune) StringMapOpt {
+	return stringMapOptFunc(func(p *stringMapParser) {
+		p.comma = comma
+	})
+}
+
+// StringMapDefault specifies a default value for a StringMap variable.
+func StringMapDefault(def map[string]string) StringMapOpt {
+	return defaultOpt(def)
+}
+
+// StringMapKeyValueSep specifies the separator between keys and values for a StringMap variable.
+func StringMapKeyValueSep(keyValueSep rune) StringMapOpt {
+	return stringMapOptFunc(func(p *stringMapParser) {
+		p.keyValueSep = keyValueSep
+	})
+}
+
+// StringMapMaxLen specifies the maximum permitted length for a StringMap variable.
+func StringMapMaxLen(maxLen int) StringMapOpt {
+	return stringMapOptFunc(func(p *stringMapParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// StringMapMinLen specifies the minimum permitted length for a StringMap variable.
+func StringMapMinLen(minLen int) StringMapOpt {
+	return stringMapOptFunc(func(p *stringMapParser) {
+		p.minLen = minLen
+	})
+}
+
+type stringMapOptFunc func(p *stringMapParser)
+
+func (f stringMapOptFunc) modifyStringMapParser(p *stringMapParser) {
+	f(p)
+}
+
+func (stringMapOptFunc) modify(*spec) {}
+
+var _ StringMapOpt = new(stringMapOptFunc)
+
+func newStringMapSpec(docOpts string, opts []StringMapOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(stringMapParser)
+	s := &spec{
+		parser:   p,
+		typeName: "map[string]string",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt StringMapOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "comma":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = StringMapComma(value[0])
+		case "key_value_sep":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = StringMapKeyValueSep(value[0])
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = StringMapMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = StringMapMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyStringMapParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyStringMapParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type stringMapParser struct {
+	comma       rune
+	keyValueSep rune
+	maxLen      int
+	minLen      int
+}
+
+func (p *stringMapParser) parse(s string) (interface{}, error) {
+	pairs, err := parsePairs(s, p.comma, p.keyValueSep)
+	if err != nil {
+		return nil, err
+	}
+	vals := make(map[string]string, len(pairs))
+	for _, kv := range pairs {
+		vals[kv[0]] = kv[1]
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+}
+
+func (p *stringMapParser) validate(v map[string]string) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *stringMapParser) format(val interface{}) string {
+	vals, _ := val.(map[string]string)
+	ses := make(map[string]string, len(vals))
+	for k, v := range vals {
+		ses[k] = v
+	}
+	return formatPairs(ses, p.comma, p.keyValueSep)
+}
+
+func (p *stringMapParser) describe() interface{} {
+	return stringMapParserDescription{
+		Comma:       p.comma,
+		KeyValueSep: p.keyValueSep,
+		MaxLen:      p.maxLen,
+		MinLen:      p.minLen,
+	}
+}
+
+type stringMapParserDescription struct {
+	Comma       rune `json:"comma,omitempty"`
+	KeyValueSep rune `json:"key_value_sep,omitempty"`
+	MaxLen      int  `json:"max_len,omitempty"`
+	MinLen      int  `json:"min_len,omitempty"`
+}
+
+func (d stringMapParserDescription) MarshalJSON() ([]byte, error) {
+	var comma string
+	if d.Comma != 0 {
+		comma = string(d.Comma)
+	}
+	var keyValueSep string
+	if d.KeyValueSep != 0 {
+		keyValueSep = string(d.KeyValueSep)
+	}
+	return json.Marshal(struct {
+		Comma       string `json:"comma,omitempty"`
+		KeyValueSep string `json:"key_value_sep,omitempty"`
+		MaxLen      int    `json:"max_len,omitempty"`
+		MinLen      int    `json:"min_len,omitempty"`
+	}{
+		Comma:       comma,
+		KeyValueSep: keyValueSep,
+		MaxLen:      d.MaxLen,
+		MinLen:      d.MinLen,
+	})
+}
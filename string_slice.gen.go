@@ -5,6 +5,7 @@ package envcfg
 import (
 	"encoding/json"
 	"errors"
+	"strconv"
 	"strings"
 )
 
@@ -17,18 +18,19 @@ import (
 // Available options:
 // 		- "comma" or StringSliceComma
 // 		- "default" or StringSliceDefault
+// 		- "file_ok" or FileOK
+// 		- "max_len" or StringSliceMaxLen
+// 		- "min_len" or StringSliceMinLen
 // 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 func (c *Cfg) StringSlice(docOpts string, opts ...StringSliceOpt) (v []string) {
 	s, err := newStringSliceSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).([]string)
+	v, _ = c.register(s).([]string)
 	return
 }
 
@@ -50,6 +52,20 @@ func StringSliceDefault(def []string) StringSliceOpt {
 	return defaultOpt(def)
 }
 
+// StringSliceMaxLen specifies the maximum permitted length for a StringSlice variable.
+func StringSliceMaxLen(maxLen int) StringSliceOpt {
+	return stringSliceOptFunc(func(p *stringSliceParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// StringSliceMinLen specifies the minimum permitted length for a StringSlice variable.
+func StringSliceMinLen(minLen int) StringSliceOpt {
+	return stringSliceOptFunc(func(p *stringSliceParser) {
+		p.minLen = minLen
+	})
+}
+
 type stringSliceOptFunc func(p *stringSliceParser)
 
 func (f stringSliceOptFunc) modifyStringSliceParser(p *stringSliceParser) {
@@ -63,7 +79,7 @@ var _ StringSliceOpt = new(stringSliceOptFunc)
 func newStringSliceSpec(docOpts string, opts []StringSliceOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(stringSliceParser)
@@ -79,7 +95,7 @@ func newStringSliceSpec(docOpts string, opts []StringSliceOpt) (*spec, error) {
 			opt StringSliceOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
+		switch key := strings.ToLower(f[0]); key {
 		case "comma":
 			value := []rune(f[1])
 			if len(value) != 1 {
@@ -87,23 +103,22 @@ func newStringSliceSpec(docOpts string, opts []StringSliceOpt) (*spec, error) {
 				break
 			}
 			opt = StringSliceComma(value[0])
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
-		case "optional":
-			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
-			}
-			opt = Optional
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = StringSliceMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = StringSliceMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyStringSliceParser(p)
@@ -116,7 +131,10 @@ func newStringSliceSpec(docOpts string, opts []StringSliceOpt) (*spec, error) {
 
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -124,22 +142,54 @@ func newStringSliceSpec(docOpts string, opts []StringSliceOpt) (*spec, error) {
 }
 
 type stringSliceParser struct {
-	comma rune
+	comma  rune
+	maxLen int
+	minLen int
 }
 
 func (p *stringSliceParser) parse(s string) (interface{}, error) {
-	return parseSlice(s, p.comma)
+	vals, err := parseSlice(s, p.comma)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+
+}
+
+func (p *stringSliceParser) validate(v []string) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
 
+func (p *stringSliceParser) format(val interface{}) string {
+	vals, _ := val.([]string)
+	ses := make([]string, len(vals))
+	for i, v := range vals {
+		ses[i] = v
+	}
+	return formatSlice(ses, p.comma)
 }
 
 func (p *stringSliceParser) describe() interface{} {
 	return stringSliceParserDescription{
-		Comma: p.comma,
+		Comma:  p.comma,
+		MaxLen: p.maxLen,
+		MinLen: p.minLen,
 	}
 }
 
 type stringSliceParserDescription struct {
-	Comma rune `json:"comma,omitempty"`
+	Comma  rune `json:"comma,omitempty"`
+	MaxLen int  `json:"max_len,omitempty"`
+	MinLen int  `json:"min_len,omitempty"`
 }
 
 func (d stringSliceParserDescription) MarshalJSON() ([]byte, error) {
@@ -148,8 +198,12 @@ func (d stringSliceParserDescription) MarshalJSON() ([]byte, error) {
 		comma = string(d.Comma)
 	}
 	return json.Marshal(struct {
-		Comma string `json:"comma,omitempty"`
+		Comma  string `json:"comma,omitempty"`
+		MaxLen int    `json:"max_len,omitempty"`
+		MinLen int    `json:"min_len,omitempty"`
 	}{
-		Comma: comma,
+		Comma:  comma,
+		MaxLen: d.MaxLen,
+		MinLen: d.MinLen,
 	})
 }
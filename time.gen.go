@@ -3,7 +3,6 @@ package envcfg
 // Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
 
 import (
-	"errors"
 	"strings"
 	"time"
 )
@@ -16,19 +15,18 @@ import (
 //
 // Available options:
 // 		- "default" or TimeDefault
+// 		- "file_ok" or FileOK
 // 		- "layout" or TimeLayout
 // 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 func (c *Cfg) Time(docOpts string, opts ...TimeOpt) (v time.Time) {
 	s, err := newTimeSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).(time.Time)
+	v, _ = c.register(s).(time.Time)
 	return
 }
 
@@ -63,7 +61,7 @@ var _ TimeOpt = new(timeOptFunc)
 func newTimeSpec(docOpts string, opts []TimeOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(timeParser)
@@ -79,27 +77,18 @@ func newTimeSpec(docOpts string, opts []TimeOpt) (*spec, error) {
 			opt TimeOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
+		switch key := strings.ToLower(f[0]); key {
 		case "layout":
 
 			opt = TimeLayout(f[1])
-		case "optional":
-			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
-			}
-			opt = Optional
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyTimeParser(p)
@@ -112,7 +101,10 @@ func newTimeSpec(docOpts string, opts []TimeOpt) (*spec, error) {
 
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -134,6 +126,11 @@ func (p *timeParser) parse(s string) (interface{}, error) {
 	)
 }
 
+func (p *timeParser) format(val interface{}) string {
+	v, _ := val.(time.Time)
+	return formatTime(v, p.layout)
+}
+
 func (p *timeParser) describe() interface{} {
 	return timeParserDescription{
 		Layout: p.layout,
@@ -0,0 +1,243 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeSlice extracts and parses a []time.Time variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe TimeSliceOpts.
+//
+// Available options:
+// 		- "comma" or TimeSliceComma
+// 		- "default" or TimeSliceDefault
+// 		- "file_ok" or FileOK
+// 		- "layout" or TimeSliceLayout
+// 		- "max_len" or TimeSliceMaxLen
+// 		- "min_len" or TimeSliceMinLen
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) TimeSlice(docOpts string, opts ...TimeSliceOpt) (v []time.Time) {
+	s, err := newTimeSliceSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).([]time.Time)
+	return
+}
+
+// TimeSliceOpt modifies TimeSlice variable configuration.
+type TimeSliceOpt interface {
+	modify(s *spec)
+	modifyTimeSliceParser(p *timeSliceParser)
+}
+
+// TimeSliceComma specifies the comma to use for a TimeSlice variable.
+func TimeSliceComma(comma rune) TimeSliceOpt {
+	return timeSliceOptFunc(func(p *timeSliceParser) {
+		p.comma = comma
+	})
+}
+
+// TimeSliceDefault specifies a default value for a TimeSlice variable.
+func TimeSliceDefault(def []time.Time) TimeSliceOpt {
+	return defaultOpt(def)
+}
+
+// TimeSliceLayout specifies the layout to use for a TimeSlice variable.
+func TimeSliceLayout(layout string) TimeSliceOpt {
+	return timeSliceOptFunc(func(p *timeSliceParser) {
+		p.layout = layout
+	})
+}
+
+// TimeSliceMaxLen specifies the maximum permitted length for a TimeSlice variable.
+func TimeSliceMaxLen(maxLen int) TimeSliceOpt {
+	return timeSliceOptFunc(func(p *timeSliceParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// TimeSliceMinLen specifies the minimum permitted length for a TimeSlice variable.
+func TimeSliceMinLen(minLen int) TimeSliceOpt {
+	return timeSliceOptFunc(func(p *timeSliceParser) {
+		p.minLen = minLen
+	})
+}
+
+type timeSliceOptFunc func(p *timeSliceParser)
+
+func (f timeSliceOptFunc) modifyTimeSliceParser(p *timeSliceParser) {
+	f(p)
+}
+
+func (timeSliceOptFunc) modify(*spec) {}
+
+var _ TimeSliceOpt = new(timeSliceOptFunc)
+
+func newTimeSliceSpec(docOpts string, opts []TimeSliceOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(timeSliceParser)
+	s := &spec{
+		parser:   p,
+		typeName: "[]time.Time",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt TimeSliceOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "comma":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = TimeSliceComma(value[0])
+		case "layout":
+
+			opt = TimeSliceLayout(f[1])
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = TimeSliceMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = TimeSliceMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyTimeSliceParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyTimeSliceParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type timeSliceParser struct {
+	comma  rune
+	layout string
+	maxLen int
+	minLen int
+}
+
+func (p *timeSliceParser) parse(s string) (interface{}, error) {
+	ses, err := parseSlice(s, p.comma)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := p.layout
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	vals := make([]time.Time, len(ses))
+	for i, v := range ses {
+		el, err := time.Parse(
+			layout,
+			v,
+		)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		vals[i] = el
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+
+}
+
+func (p *timeSliceParser) validate(v []time.Time) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *timeSliceParser) format(val interface{}) string {
+	vals, _ := val.([]time.Time)
+	ses := make([]string, len(vals))
+	for i, v := range vals {
+		ses[i] = formatTime(v, p.layout)
+	}
+	return formatSlice(ses, p.comma)
+}
+
+func (p *timeSliceParser) describe() interface{} {
+	return timeSliceParserDescription{
+		Comma:  p.comma,
+		Layout: p.layout,
+		MaxLen: p.maxLen,
+		MinLen: p.minLen,
+	}
+}
+
+type timeSliceParserDescription struct {
+	Comma  rune   `json:"comma,omitempty"`
+	Layout string `json:"layout,omitempty"`
+	MaxLen int    `json:"max_len,omitempty"`
+	MinLen int    `json:"min_len,omitempty"`
+}
+
+func (d timeSliceParserDescription) MarshalJSON() ([]byte, error) {
+	var comma string
+	if d.Comma != 0 {
+		comma = string(d.Comma)
+	}
+	return json.Marshal(struct {
+		Comma  string `json:"comma,omitempty"`
+		Layout string `json:"layout,omitempty"`
+		MaxLen int    `json:"max_len,omitempty"`
+		MinLen int    `json:"min_len,omitempty"`
+	}{
+		Comma:  comma,
+		Layout: d.Layout,
+		MaxLen: d.MaxLen,
+		MinLen: d.MinLen,
+	})
+}
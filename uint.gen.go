@@ -3,7 +3,6 @@ package envcfg
 // Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
 
 import (
-	"errors"
 	"strconv"
 	"strings"
 )
@@ -18,18 +17,19 @@ import (
 // 		- "base" or UintBase
 // 		- "bit_size" or UintBitSize
 // 		- "default" or UintDefault
+// 		- "file_ok" or FileOK
+// 		- "max" or UintMax
+// 		- "min" or UintMin
 // 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
 func (c *Cfg) Uint(docOpts string, opts ...UintOpt) (v uint64) {
 	s, err := newUintSpec(docOpts, opts)
 	if err != nil {
-		if c.panic {
-			panic(err)
-		}
-		c.addError(err)
+		c.addError(c.qualify(err))
 		return
 	}
-	c.addDescription(s.describe())
-	v, _ = c.evaluate(s).(uint64)
+	v, _ = c.register(s).(uint64)
 	return
 }
 
@@ -58,6 +58,20 @@ func UintDefault(def uint64) UintOpt {
 	return defaultOpt(def)
 }
 
+// UintMax specifies the maximum permitted value for a Uint variable.
+func UintMax(max uint64) UintOpt {
+	return uintOptFunc(func(p *uintParser) {
+		p.max = &max
+	})
+}
+
+// UintMin specifies the minimum permitted value for a Uint variable.
+func UintMin(min uint64) UintOpt {
+	return uintOptFunc(func(p *uintParser) {
+		p.min = &min
+	})
+}
+
 type uintOptFunc func(p *uintParser)
 
 func (f uintOptFunc) modifyUintParser(p *uintParser) {
@@ -71,7 +85,7 @@ var _ UintOpt = new(uintOptFunc)
 func newUintSpec(docOpts string, opts []UintOpt) (*spec, error) {
 	parsed, err := parse(docOpts)
 	if err != nil {
-		return nil, err
+		return nil, &DocOptsError{Err: err}
 	}
 
 	p := new(uintParser)
@@ -82,12 +96,13 @@ func newUintSpec(docOpts string, opts []UintOpt) (*spec, error) {
 		comment:  parsed.description,
 	}
 
+	values := make(map[string]string)
 	for _, f := range parsed.fields {
 		var (
 			opt UintOpt
 			err error
 		)
-		switch strings.ToLower(f[0]) {
+		switch key := strings.ToLower(f[0]); key {
 		case "base":
 			var val int
 			val, err = strconv.Atoi(f[1])
@@ -96,23 +111,20 @@ func newUintSpec(docOpts string, opts []UintOpt) (*spec, error) {
 			var val int
 			val, err = strconv.Atoi(f[1])
 			opt = UintBitSize(val)
-		case "default":
-			val := f[1]
-			opt = uniOptFunc(func(s *spec) {
-				s.flags |= flagDefaultValString | flagDefaultVal
-				s.defaultValS = val
-			})
-		case "optional":
-			if f[1] != "" {
-				err = errors.New("optional does not take any arguments")
-			}
-			opt = Optional
+		case "max":
+			values[key] = f[1]
+			continue
+		case "min":
+			values[key] = f[1]
+			continue
+		default:
+			opt, err = parseUniOpt(key, f[1])
 		}
 		if err != nil {
-			return nil, err
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
 		}
 		if opt == nil {
-			return nil, errors.New("unknown")
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
 		}
 		opt.modify(s)
 		opt.modifyUintParser(p)
@@ -123,9 +135,35 @@ func newUintSpec(docOpts string, opts []UintOpt) (*spec, error) {
 		opt.modifyUintParser(p)
 	}
 
+	// value params are parsed once the options they depend on are known, but typed options take precedence
+	if val, ok := values["max"]; ok {
+		v, err := p.parse(val)
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "max", Err: err}
+		}
+		if p.max == nil {
+			max := v.(uint64)
+			p.max = &max
+		}
+	}
+
+	if val, ok := values["min"]; ok {
+		v, err := p.parse(val)
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: "min", Err: err}
+		}
+		if p.min == nil {
+			min := v.(uint64)
+			p.min = &min
+		}
+	}
+
 	if s.flags&flagDefaultValString > 0 {
 		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
-			return nil, err
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
 		}
 	}
 
@@ -135,24 +173,57 @@ func newUintSpec(docOpts string, opts []UintOpt) (*spec, error) {
 type uintParser struct {
 	base    int
 	bitSize int
+	max     *uint64
+	min     *uint64
 }
 
 func (p *uintParser) parse(s string) (interface{}, error) {
-	return strconv.ParseUint(
+	v, err := strconv.ParseUint(
 		s,
 		p.base,
 		p.bitSize,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.validate(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *uintParser) validate(v uint64) error {
+	if p.min != nil && v < *p.min {
+		return valueErrorf("must be at least %v", p.format(*p.min))
+	}
+	if p.max != nil && v > *p.max {
+		return valueErrorf("must be at most %v", p.format(*p.max))
+	}
+	return nil
+}
+
+func (p *uintParser) format(val interface{}) string {
+	v, _ := val.(uint64)
+	return formatUint(v, p.base)
 }
 
 func (p *uintParser) describe() interface{} {
-	return uintParserDescription{
+	d := uintParserDescription{
 		Base:    p.base,
 		BitSize: p.bitSize,
 	}
+	if p.max != nil {
+		d.Max = p.format(*p.max)
+	}
+	if p.min != nil {
+		d.Min = p.format(*p.min)
+	}
+	return d
 }
 
 type uintParserDescription struct {
-	Base    int `json:"base,omitempty"`
-	BitSize int `json:"bit_size,omitempty"`
+	Base    int    `json:"base,omitempty"`
+	BitSize int    `json:"bit_size,omitempty"`
+	Max     string `json:"max,omitempty"`
+	Min     string `json:"min,omitempty"`
 }
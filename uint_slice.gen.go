@@ -0,0 +1,256 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// UintSlice extracts and parses a []uint64 variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe UintSliceOpts.
+//
+// Available options:
+// 		- "base" or UintSliceBase
+// 		- "bit_size" or UintSliceBitSize
+// 		- "comma" or UintSliceComma
+// 		- "default" or UintSliceDefault
+// 		- "file_ok" or FileOK
+// 		- "max_len" or UintSliceMaxLen
+// 		- "min_len" or UintSliceMinLen
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "secret" or Secret
+func (c *Cfg) UintSlice(docOpts string, opts ...UintSliceOpt) (v []uint64) {
+	s, err := newUintSliceSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).([]uint64)
+	return
+}
+
+// UintSliceOpt modifies UintSlice variable configuration.
+type UintSliceOpt interface {
+	modify(s *spec)
+	modifyUintSliceParser(p *uintSliceParser)
+}
+
+// UintSliceBase specifies the base to use for a UintSlice variable.
+func UintSliceBase(base int) UintSliceOpt {
+	return uintSliceOptFunc(func(p *uintSliceParser) {
+		p.base = base
+	})
+}
+
+// UintSliceBitSize specifies the bit size to use for a UintSlice variable.
+func UintSliceBitSize(bitSize int) UintSliceOpt {
+	return uintSliceOptFunc(func(p *uintSliceParser) {
+		p.bitSize = bitSize
+	})
+}
+
+// UintSliceComma specifies the comma to use for a UintSlice variable.
+func UintSliceComma(comma rune) UintSliceOpt {
+	return uintSliceOptFunc(func(p *uintSliceParser) {
+		p.comma = comma
+	})
+}
+
+// UintSliceDefault specifies a default value for a UintSlice variable.
+func UintSliceDefault(def []uint64) UintSliceOpt {
+	return defaultOpt(def)
+}
+
+// UintSliceMaxLen specifies the maximum permitted length for a UintSlice variable.
+func UintSliceMaxLen(maxLen int) UintSliceOpt {
+	return uintSliceOptFunc(func(p *uintSliceParser) {
+		p.maxLen = maxLen
+	})
+}
+
+// UintSliceMinLen specifies the minimum permitted length for a UintSlice variable.
+func UintSliceMinLen(minLen int) UintSliceOpt {
+	return uintSliceOptFunc(func(p *uintSliceParser) {
+		p.minLen = minLen
+	})
+}
+
+type uintSliceOptFunc func(p *uintSliceParser)
+
+func (f uintSliceOptFunc) modifyUintSliceParser(p *uintSliceParser) {
+	f(p)
+}
+
+func (uintSliceOptFunc) modify(*spec) {}
+
+var _ UintSliceOpt = new(uintSliceOptFunc)
+
+func newUintSliceSpec(docOpts string, opts []UintSliceOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(uintSliceParser)
+	s := &spec{
+		parser:   p,
+		typeName: "[]uint64",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt UintSliceOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "base":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = UintSliceBase(val)
+		case "bit_size":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = UintSliceBitSize(val)
+		case "comma":
+			value := []rune(f[1])
+			if len(value) != 1 {
+				err = errors.New("must be only one rune")
+				break
+			}
+			opt = UintSliceComma(value[0])
+		case "max_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = UintSliceMaxLen(val)
+		case "min_len":
+			var val int
+			val, err = strconv.Atoi(f[1])
+			opt = UintSliceMinLen(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyUintSliceParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyUintSliceParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type uintSliceParser struct {
+	base    int
+	bitSize int
+	comma   rune
+	maxLen  int
+	minLen  int
+}
+
+func (p *uintSliceParser) parse(s string) (interface{}, error) {
+	ses, err := parseSlice(s, p.comma)
+	if err != nil {
+		return nil, err
+	}
+
+	vals := make([]uint64, len(ses))
+	for i, v := range ses {
+		el, err := strconv.ParseUint(
+			v,
+			p.base,
+			p.bitSize,
+		)
+		if err != nil {
+			return nil, indexError(i, err)
+		}
+		vals[i] = el
+	}
+	if err := p.validate(vals); err != nil {
+		return nil, err
+	}
+	return vals, nil
+
+}
+
+func (p *uintSliceParser) validate(v []uint64) error {
+	if len(v) < p.minLen {
+		return valueErrorf("length must be at least %v", p.minLen)
+	}
+	if p.maxLen > 0 && len(v) > p.maxLen {
+		return valueErrorf("length must be at most %v", p.maxLen)
+	}
+	return nil
+}
+
+func (p *uintSliceParser) format(val interface{}) string {
+	vals, _ := val.([]uint64)
+	ses := make([]string, len(vals))
+	for i, v := range vals {
+		ses[i] = formatUint(v, p.base)
+	}
+	return formatSlice(ses, p.comma)
+}
+
+func (p *uintSliceParser) describe() interface{} {
+	return uintSliceParserDescription{
+		Base:    p.base,
+		BitSize: p.bitSize,
+		Comma:   p.comma,
+		MaxLen:  p.maxLen,
+		MinLen:  p.minLen,
+	}
+}
+
+type uintSliceParserDescription struct {
+	Base    int  `json:"base,omitempty"`
+	BitSize int  `json:"bit_size,omitempty"`
+	Comma   rune `json:"comma,omitempty"`
+	MaxLen  int  `json:"max_len,omitempty"`
+	MinLen  int  `json:"min_len,omitempty"`
+}
+
+func (d uintSliceParserDescription) MarshalJSON() ([]byte, error) {
+	var comma string
+	if d.Comma != 0 {
+		comma = string(d.Comma)
+	}
+	return json.Marshal(struct {
+		Base    int    `json:"base,omitempty"`
+		BitSize int    `json:"bit_size,omitempty"`
+		Comma   string `json:"comma,omitempty"`
+		MaxLen  int    `json:"max_len,omitempty"`
+		MinLen  int    `json:"min_len,omitempty"`
+	}{
+		Base:    d.Base,
+		BitSize: d.BitSize,
+		Comma:   comma,
+		MaxLen:  d.MaxLen,
+		MinLen:  d.MinLen,
+	})
+}
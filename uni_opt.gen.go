@@ -5,16 +5,29 @@ package envcfg
 type UniOpt interface {
 	modify(s *spec)
 	modifyBoolParser(p *boolParser)
+	modifyBoolSliceParser(p *boolSliceParser)
+	modifyByteSizeParser(p *byteSizeParser)
 	modifyBytesParser(p *bytesParser)
 	modifyDurationParser(p *durationParser)
+	modifyDurationMapParser(p *durationMapParser)
+	modifyDurationSliceParser(p *durationSliceParser)
 	modifyFloatParser(p *floatParser)
+	modifyFloatSliceParser(p *floatSliceParser)
 	modifyIntParser(p *intParser)
+	modifyIntMapParser(p *intMapParser)
 	modifyIntSliceParser(p *intSliceParser)
 	modifyIPParser(p *ipParser)
+	modifyIPNetParser(p *ipNetParser)
+	modifyIPNetSliceParser(p *ipNetSliceParser)
+	modifyIPSliceParser(p *ipSliceParser)
 	modifyStringParser(p *stringParser)
+	modifyStringMapParser(p *stringMapParser)
 	modifyStringSliceParser(p *stringSliceParser)
 	modifyTimeParser(p *timeParser)
+	modifyTimeSliceParser(p *timeSliceParser)
+	modifyURLParser(p *urlParser)
 	modifyUintParser(p *uintParser)
+	modifyUintSliceParser(p *uintSliceParser)
 }
 
 type uniOptFunc func(s *spec)
@@ -25,24 +38,50 @@ func (f uniOptFunc) modify(s *spec) {
 
 func (uniOptFunc) modifyBoolParser(p *boolParser) {}
 
+func (uniOptFunc) modifyBoolSliceParser(p *boolSliceParser) {}
+
+func (uniOptFunc) modifyByteSizeParser(p *byteSizeParser) {}
+
 func (uniOptFunc) modifyBytesParser(p *bytesParser) {}
 
 func (uniOptFunc) modifyDurationParser(p *durationParser) {}
 
+func (uniOptFunc) modifyDurationMapParser(p *durationMapParser) {}
+
+func (uniOptFunc) modifyDurationSliceParser(p *durationSliceParser) {}
+
 func (uniOptFunc) modifyFloatParser(p *floatParser) {}
 
+func (uniOptFunc) modifyFloatSliceParser(p *floatSliceParser) {}
+
 func (uniOptFunc) modifyIntParser(p *intParser) {}
 
+func (uniOptFunc) modifyIntMapParser(p *intMapParser) {}
+
 func (uniOptFunc) modifyIntSliceParser(p *intSliceParser) {}
 
 func (uniOptFunc) modifyIPParser(p *ipParser) {}
 
+func (uniOptFunc) modifyIPNetParser(p *ipNetParser) {}
+
+func (uniOptFunc) modifyIPNetSliceParser(p *ipNetSliceParser) {}
+
+func (uniOptFunc) modifyIPSliceParser(p *ipSliceParser) {}
+
 func (uniOptFunc) modifyStringParser(p *stringParser) {}
 
+func (uniOptFunc) modifyStringMapParser(p *stringMapParser) {}
+
 func (uniOptFunc) modifyStringSliceParser(p *stringSliceParser) {}
 
 func (uniOptFunc) modifyTimeParser(p *timeParser) {}
 
+func (uniOptFunc) modifyTimeSliceParser(p *timeSliceParser) {}
+
+func (uniOptFunc) modifyURLParser(p *urlParser) {}
+
 func (uniOptFunc) modifyUintParser(p *uintParser) {}
 
+func (uniOptFunc) modifyUintSliceParser(p *uintSliceParser) {}
+
 var _ UniOpt = new(uniOptFunc)
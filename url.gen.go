@@ -0,0 +1,158 @@
+package envcfg
+
+// Code generated by internal/cmd/gen/gen.go DO NOT EDIT.
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// URL extracts and parses a *url.URL variable using the options provided.
+//
+// The first argument must be a string with beginning with the variable name as expected in the process environment.
+// Any other options -- none of which are required -- may either be specified in the remainder of the string or using
+// the type-safe URLOpts.
+//
+// Available options:
+// 		- "default" or URLDefault
+// 		- "file_ok" or FileOK
+// 		- "optional" or Optional
+// 		- "reloadable" or Reloadable
+// 		- "require_host" or URLRequireHost
+// 		- "schemes" or URLSchemes
+// 		- "secret" or Secret
+func (c *Cfg) URL(docOpts string, opts ...URLOpt) (v *url.URL) {
+	s, err := newURLSpec(docOpts, opts)
+	if err != nil {
+		c.addError(c.qualify(err))
+		return
+	}
+	v, _ = c.register(s).(*url.URL)
+	return
+}
+
+// URLOpt modifies URL variable configuration.
+type URLOpt interface {
+	modify(s *spec)
+	modifyURLParser(p *urlParser)
+}
+
+// URLDefault specifies a default value for a URL variable.
+func URLDefault(def *url.URL) URLOpt {
+	return defaultOpt(def)
+}
+
+// URLRequireHost specifies that a host is required for a URL variable.
+func URLRequireHost(requireHost bool) URLOpt {
+	return urlOptFunc(func(p *urlParser) {
+		p.requireHost = requireHost
+	})
+}
+
+// URLSchemes specifies the permitted schemes for a URL variable.
+func URLSchemes(schemes []string) URLOpt {
+	return urlOptFunc(func(p *urlParser) {
+		p.schemes = schemes
+	})
+}
+
+type urlOptFunc func(p *urlParser)
+
+func (f urlOptFunc) modifyURLParser(p *urlParser) {
+	f(p)
+}
+
+func (urlOptFunc) modify(*spec) {}
+
+var _ URLOpt = new(urlOptFunc)
+
+func newURLSpec(docOpts string, opts []URLOpt) (*spec, error) {
+	parsed, err := parse(docOpts)
+	if err != nil {
+		return nil, &DocOptsError{Err: err}
+	}
+
+	p := new(urlParser)
+	s := &spec{
+		parser:   p,
+		typeName: "*url.URL",
+		name:     parsed.name,
+		comment:  parsed.description,
+	}
+
+	for _, f := range parsed.fields {
+		var (
+			opt URLOpt
+			err error
+		)
+		switch key := strings.ToLower(f[0]); key {
+		case "require_host":
+			val := true // a bare flag is set
+			if f[1] != "" {
+				val, err = strconv.ParseBool(f[1])
+			}
+			opt = URLRequireHost(val)
+		case "schemes":
+			var val []string
+			val, err = parseSlice(f[1], 0)
+			opt = URLSchemes(val)
+		default:
+			opt, err = parseUniOpt(key, f[1])
+		}
+		if err != nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: err}
+		}
+		if opt == nil {
+			return nil, &DocOptsError{Name: s.name, Key: f[0], Err: ErrUnknownOption}
+		}
+		opt.modify(s)
+		opt.modifyURLParser(p)
+	}
+
+	for _, opt := range opts {
+		opt.modify(s)
+		opt.modifyURLParser(p)
+	}
+
+	if s.flags&flagDefaultValString > 0 {
+		if s.defaultVal, err = p.parse(s.defaultValS); err != nil {
+			if s.flags&flagSecret > 0 {
+				err = &redactedError{err: err}
+			}
+			return nil, &DocOptsError{Name: s.name, Key: "default", Err: err}
+		}
+	}
+
+	return s, nil
+}
+
+type urlParser struct {
+	requireHost bool
+	schemes     []string
+}
+
+func (p *urlParser) parse(s string) (interface{}, error) {
+	return parseURL(
+		s,
+		p.schemes,
+		p.requireHost,
+	)
+}
+
+func (p *urlParser) format(val interface{}) string {
+	v, _ := val.(*url.URL)
+	return formatURL(v)
+}
+
+func (p *urlParser) describe() interface{} {
+	return urlParserDescription{
+		RequireHost: p.requireHost,
+		Schemes:     p.schemes,
+	}
+}
+
+type urlParserDescription struct {
+	RequireHost bool     `json:"require_host,omitempty"`
+	Schemes     []string `json:"schemes,omitempty"`
+}